@@ -0,0 +1,376 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+// ConversionDataAnnotation stores the Hub version of the object when converting to v1alpha1,
+// allowing to restore the fields which can't be represented in v1alpha1 with no data loss.
+const ConversionDataAnnotation = "controlplane.cluster.x-k8s.io/conversion-data"
+
+// ConvertTo converts this StewardControlPlane to the Hub version (v1alpha2).
+func (src *StewardControlPlane) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.StewardControlPlane) //nolint:forcetypeassert
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = convertSpecToHub(src.Spec)
+	dst.Status = convertStatusToHub(src.Status)
+	// Manage the conversion data annotation, restoring the fields not available in v1alpha1.
+	restored := &v1alpha2.StewardControlPlane{}
+	if ok, err := unmarshalConversionData(dst, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.Remediation = restored.Spec.Remediation
+	dst.Spec.UserKubeconfig = restored.Spec.UserKubeconfig
+	dst.Spec.ClusterSecrets = restored.Spec.ClusterSecrets
+	dst.Spec.KubeconfigServer = restored.Spec.KubeconfigServer
+	dst.Spec.CertificateAuthority = restored.Spec.CertificateAuthority
+	dst.Status.Remediation = restored.Status.Remediation
+	dst.Status.Hosting = restored.Status.Hosting
+	dst.Status.PreviousHosting = restored.Status.PreviousHosting
+	dst.Status.Migration = restored.Status.Migration
+
+	if ecr := dst.Spec.Deployment.ExternalClusterReference; ecr != nil && restored.Spec.Deployment.ExternalClusterReference != nil {
+		ecr.ClusterRef = restored.Spec.Deployment.ExternalClusterReference.ClusterRef
+		ecr.ManagedNamespace = restored.Spec.Deployment.ExternalClusterReference.ManagedNamespace
+		ecr.Naming = restored.Spec.Deployment.ExternalClusterReference.Naming
+	}
+	dst.Status.Certificates = restored.Status.Certificates
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha2) to this version.
+func (dst *StewardControlPlane) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.StewardControlPlane) //nolint:forcetypeassert
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec = convertSpecFromHub(src.Spec)
+	dst.Status = convertStatusFromHub(src.Status)
+
+	return marshalConversionData(src, dst)
+}
+
+// ConvertTo converts this StewardControlPlaneTemplate to the Hub version (v1alpha2).
+func (src *StewardControlPlaneTemplate) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.StewardControlPlaneTemplate) //nolint:forcetypeassert
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec.Template.ObjectMeta = src.Spec.Template.ObjectMeta
+	dst.Spec.Template.Spec = convertFieldsToHub(src.Spec.Template.Spec)
+	// Manage the conversion data annotation, restoring the fields not available in v1alpha1.
+	restored := &v1alpha2.StewardControlPlaneTemplate{}
+	if ok, err := unmarshalConversionData(dst, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.Template.Spec.Remediation = restored.Spec.Template.Spec.Remediation
+	dst.Spec.Template.Spec.UserKubeconfig = restored.Spec.Template.Spec.UserKubeconfig
+	dst.Spec.Template.Spec.ClusterSecrets = restored.Spec.Template.Spec.ClusterSecrets
+	dst.Spec.Template.Spec.KubeconfigServer = restored.Spec.Template.Spec.KubeconfigServer
+	dst.Spec.Template.Spec.CertificateAuthority = restored.Spec.Template.Spec.CertificateAuthority
+
+	if ecr := dst.Spec.Template.Spec.Deployment.ExternalClusterReference; ecr != nil && restored.Spec.Template.Spec.Deployment.ExternalClusterReference != nil {
+		ecr.ClusterRef = restored.Spec.Template.Spec.Deployment.ExternalClusterReference.ClusterRef
+		ecr.ManagedNamespace = restored.Spec.Template.Spec.Deployment.ExternalClusterReference.ManagedNamespace
+		ecr.Naming = restored.Spec.Template.Spec.Deployment.ExternalClusterReference.Naming
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha2) to this version.
+func (dst *StewardControlPlaneTemplate) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.StewardControlPlaneTemplate) //nolint:forcetypeassert
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	dst.Spec.Template.ObjectMeta = src.Spec.Template.ObjectMeta
+	dst.Spec.Template.Spec = convertFieldsFromHub(src.Spec.Template.Spec)
+
+	return marshalConversionData(src, dst)
+}
+
+func convertSpecToHub(in StewardControlPlaneSpec) v1alpha2.StewardControlPlaneSpec {
+	return v1alpha2.StewardControlPlaneSpec{
+		StewardControlPlaneFields: convertFieldsToHub(in.StewardControlPlaneFields),
+		ControlPlaneEndpoint:      in.ControlPlaneEndpoint,
+		Replicas:                  in.Replicas,
+		Version:                   in.Version,
+	}
+}
+
+func convertSpecFromHub(in v1alpha2.StewardControlPlaneSpec) StewardControlPlaneSpec {
+	return StewardControlPlaneSpec{
+		StewardControlPlaneFields: convertFieldsFromHub(in.StewardControlPlaneFields),
+		ControlPlaneEndpoint:      in.ControlPlaneEndpoint,
+		Replicas:                  in.Replicas,
+		Version:                   in.Version,
+	}
+}
+
+func convertFieldsToHub(in StewardControlPlaneFields) v1alpha2.StewardControlPlaneFields {
+	return v1alpha2.StewardControlPlaneFields{
+		DataStoreName:        in.DataStoreName,
+		DataStoreSchema:      in.DataStoreSchema,
+		DataStoreUsername:    in.DataStoreUsername,
+		Addons:               convertAddonsToHub(in.Addons),
+		AdmissionControllers: in.AdmissionControllers,
+		ContainerRegistry:    in.ContainerRegistry,
+		ControllerManager:    v1alpha2.ControlPlaneComponent(in.ControllerManager),
+		APIServer:            v1alpha2.ControlPlaneComponent(in.ApiServer),
+		Scheduler:            v1alpha2.ControlPlaneComponent(in.Scheduler),
+		Kine:                 v1alpha2.KineComponent(in.Kine),
+		Kubelet:              in.Kubelet,
+		Network:              convertNetworkToHub(in.Network),
+		Deployment:           convertDeploymentToHub(in.Deployment),
+	}
+}
+
+func convertFieldsFromHub(in v1alpha2.StewardControlPlaneFields) StewardControlPlaneFields {
+	return StewardControlPlaneFields{
+		DataStoreName:        in.DataStoreName,
+		DataStoreSchema:      in.DataStoreSchema,
+		DataStoreUsername:    in.DataStoreUsername,
+		Addons:               convertAddonsFromHub(in.Addons),
+		AdmissionControllers: in.AdmissionControllers,
+		ContainerRegistry:    in.ContainerRegistry,
+		ControllerManager:    ControlPlaneComponent(in.ControllerManager),
+		ApiServer:            ControlPlaneComponent(in.APIServer),
+		Scheduler:            ControlPlaneComponent(in.Scheduler),
+		Kine:                 KineComponent(in.Kine),
+		Kubelet:              in.Kubelet,
+		Network:              convertNetworkFromHub(in.Network),
+		Deployment:           convertDeploymentFromHub(in.Deployment),
+	}
+}
+
+// convertAddonsToHub merges the CoreDNS addon with the other ones:
+// the CoreDNS field of the inlined Steward addons is shadowed in v1alpha1, and can't be persisted.
+func convertAddonsToHub(in AddonsSpec) v1alpha2.AddonsSpec {
+	out := v1alpha2.AddonsSpec{
+		Konnectivity:    in.Konnectivity,
+		KubeProxy:       in.KubeProxy,
+		TCPProxy:        in.TCPProxy,
+		WorkerBootstrap: in.WorkerBootstrap,
+	}
+
+	if in.CoreDNS != nil {
+		out.CoreDNS = &v1alpha2.CoreDNSAddonSpec{
+			AddonSpec:     ptr.Deref(in.CoreDNS.AddonSpec, stewardv1alpha1.AddonSpec{}),
+			DNSServiceIPs: in.CoreDNS.DNSServiceIPs,
+		}
+	}
+
+	return out
+}
+
+func convertAddonsFromHub(in v1alpha2.AddonsSpec) AddonsSpec {
+	out := AddonsSpec{
+		AddonsSpec: stewardv1alpha1.AddonsSpec{
+			Konnectivity:    in.Konnectivity,
+			KubeProxy:       in.KubeProxy,
+			TCPProxy:        in.TCPProxy,
+			WorkerBootstrap: in.WorkerBootstrap,
+		},
+	}
+
+	if in.CoreDNS != nil {
+		out.CoreDNS = &CoreDNSAddonSpec{DNSServiceIPs: in.CoreDNS.DNSServiceIPs}
+		// An empty inlined pointer can't be distinguished from a nil one once serialized.
+		if in.CoreDNS.AddonSpec != (stewardv1alpha1.AddonSpec{}) {
+			out.CoreDNS.AddonSpec = ptr.To(in.CoreDNS.AddonSpec)
+		}
+	}
+
+	return out
+}
+
+func convertNetworkToHub(in NetworkComponent) v1alpha2.NetworkComponent {
+	return v1alpha2.NetworkComponent{
+		LoadBalancerConfig: (*v1alpha2.LoadBalancerConfig)(in.LoadBalancerConfig),
+		Ingress:            (*v1alpha2.IngressComponent)(in.Ingress),
+		Gateway:            (*v1alpha2.GatewayComponent)(in.Gateway),
+		ServiceType:        in.ServiceType,
+		ServiceAddress:     in.ServiceAddress,
+		ServiceLabels:      in.ServiceLabels,
+		ServiceAnnotations: in.ServiceAnnotations,
+		CertSANs:           in.CertSANs,
+		DNSServiceIPs:      in.DNSServiceIPs,
+	}
+}
+
+func convertNetworkFromHub(in v1alpha2.NetworkComponent) NetworkComponent {
+	return NetworkComponent{
+		LoadBalancerConfig: (*LoadBalancerConfig)(in.LoadBalancerConfig),
+		Ingress:            (*IngressComponent)(in.Ingress),
+		Gateway:            (*GatewayComponent)(in.Gateway),
+		ServiceType:        in.ServiceType,
+		ServiceAddress:     in.ServiceAddress,
+		ServiceLabels:      in.ServiceLabels,
+		ServiceAnnotations: in.ServiceAnnotations,
+		CertSANs:           in.CertSANs,
+		DNSServiceIPs:      in.DNSServiceIPs,
+	}
+}
+
+func convertDeploymentToHub(in DeploymentComponent) v1alpha2.DeploymentComponent {
+	return v1alpha2.DeploymentComponent{
+		NodeSelector:              in.NodeSelector,
+		RuntimeClassName:          in.RuntimeClassName,
+		AdditionalMetadata:        in.AdditionalMetadata,
+		PodAdditionalMetadata:     in.PodAdditionalMetadata,
+		ServiceAccountName:        in.ServiceAccountName,
+		Strategy:                  in.Strategy,
+		Affinity:                  in.Affinity,
+		Tolerations:               in.Tolerations,
+		TopologySpreadConstraints: in.TopologySpreadConstraints,
+		ExtraInitContainers:       in.ExtraInitContainers,
+		ExtraContainers:           in.ExtraContainers,
+		ExtraVolumes:              in.ExtraVolumes,
+		ExternalClusterReference:  convertExternalClusterReferenceToHub(in.ExternalClusterReference),
+	}
+}
+
+func convertDeploymentFromHub(in v1alpha2.DeploymentComponent) DeploymentComponent {
+	return DeploymentComponent{
+		NodeSelector:              in.NodeSelector,
+		RuntimeClassName:          in.RuntimeClassName,
+		AdditionalMetadata:        in.AdditionalMetadata,
+		PodAdditionalMetadata:     in.PodAdditionalMetadata,
+		ServiceAccountName:        in.ServiceAccountName,
+		Strategy:                  in.Strategy,
+		Affinity:                  in.Affinity,
+		Tolerations:               in.Tolerations,
+		TopologySpreadConstraints: in.TopologySpreadConstraints,
+		ExtraInitContainers:       in.ExtraInitContainers,
+		ExtraContainers:           in.ExtraContainers,
+		ExtraVolumes:              in.ExtraVolumes,
+		ExternalClusterReference:  convertExternalClusterReferenceFromHub(in.ExternalClusterReference),
+	}
+}
+
+func convertExternalClusterReferenceToHub(in *ExternalClusterReference) *v1alpha2.ExternalClusterReference {
+	if in == nil {
+		return nil
+	}
+
+	return &v1alpha2.ExternalClusterReference{
+		KubeconfigSecretName:      in.KubeconfigSecretName,
+		KubeconfigSecretKey:       in.KubeconfigSecretKey,
+		KubeconfigSecretNamespace: in.KubeconfigSecretNamespace,
+		DeploymentNamespace:       in.DeploymentNamespace,
+	}
+}
+
+func convertExternalClusterReferenceFromHub(in *v1alpha2.ExternalClusterReference) *ExternalClusterReference {
+	if in == nil {
+		return nil
+	}
+
+	return &ExternalClusterReference{
+		KubeconfigSecretName:      in.KubeconfigSecretName,
+		KubeconfigSecretKey:       in.KubeconfigSecretKey,
+		KubeconfigSecretNamespace: in.KubeconfigSecretNamespace,
+		DeploymentNamespace:       in.DeploymentNamespace,
+	}
+}
+
+func convertStatusToHub(in StewardControlPlaneStatus) v1alpha2.StewardControlPlaneStatus {
+	return v1alpha2.StewardControlPlaneStatus{
+		Initialization:      (*v1alpha2.StewardControlPlaneInitializationStatus)(in.Initialization),
+		ObservedGeneration:  in.ObservedGeneration,
+		Initialized:         in.Initialized,
+		Ready:               in.Ready,
+		ReadyReplicas:       in.ReadyReplicas,
+		Replicas:            in.Replicas,
+		Selector:            in.Selector,
+		UnavailableReplicas: in.UnavailableReplicas,
+		UpdatedReplicas:     in.UpdatedReplicas,
+		AvailableReplicas:   in.AvailableReplicas,
+		UpToDateReplicas:    in.UpToDateReplicas,
+		// The v1alpha1 field is defaulted to true by the API server.
+		ExternalManagedControlPlane: ptr.Deref(in.ExternalManagedControlPlane, true),
+		FailureReason:               in.FailureReason,
+		FailureMessage:              in.FailureMessage,
+		Version:                     in.Version,
+		Conditions:                  in.Conditions,
+	}
+}
+
+func convertStatusFromHub(in v1alpha2.StewardControlPlaneStatus) StewardControlPlaneStatus {
+	return StewardControlPlaneStatus{
+		Initialization:              (*StewardControlPlaneInitializationStatus)(in.Initialization),
+		ObservedGeneration:          in.ObservedGeneration,
+		Initialized:                 in.Initialized,
+		Ready:                       in.Ready,
+		ReadyReplicas:               in.ReadyReplicas,
+		Replicas:                    in.Replicas,
+		Selector:                    in.Selector,
+		UnavailableReplicas:         in.UnavailableReplicas,
+		UpdatedReplicas:             in.UpdatedReplicas,
+		AvailableReplicas:           in.AvailableReplicas,
+		UpToDateReplicas:            in.UpToDateReplicas,
+		ExternalManagedControlPlane: ptr.To(in.ExternalManagedControlPlane),
+		FailureReason:               in.FailureReason,
+		FailureMessage:              in.FailureMessage,
+		Version:                     in.Version,
+		Conditions:                  in.Conditions,
+	}
+}
+
+// marshalConversionData stores the source object, except its metadata, in the destination object annotations.
+func marshalConversionData(src, dst metav1.Object) error {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(src)
+	if err != nil {
+		return errors.Wrap(err, "cannot convert object to unstructured")
+	}
+
+	delete(u, "metadata")
+
+	data, err := json.Marshal(u)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal conversion data")
+	}
+
+	annotations := dst.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[ConversionDataAnnotation] = string(data)
+	dst.SetAnnotations(annotations)
+
+	return nil
+}
+
+// unmarshalConversionData retrieves the data stored by marshalConversionData, removing the annotation from the object.
+func unmarshalConversionData(from metav1.Object, to any) (bool, error) {
+	annotations := from.GetAnnotations()
+
+	data, ok := annotations[ConversionDataAnnotation]
+	if !ok {
+		return false, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), to); err != nil {
+		return false, errors.Wrap(err, "cannot unmarshal conversion data")
+	}
+
+	delete(annotations, ConversionDataAnnotation)
+	from.SetAnnotations(annotations)
+
+	return true, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/gomega" //nolint:revive
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/randfill"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+const fuzzIterations = 1000
+
+func TestFuzzyConversion(t *testing.T) {
+	t.Run("for StewardControlPlane", fuzzTestFunc(&v1alpha2.StewardControlPlane{}, &StewardControlPlane{}))
+	t.Run("for StewardControlPlaneTemplate", fuzzTestFunc(&v1alpha2.StewardControlPlaneTemplate{}, &StewardControlPlaneTemplate{}))
+}
+
+// spokeFuzzerFuncs prevents the fuzzer from generating v1alpha1 values which can't be persisted by the API server.
+func spokeFuzzerFuncs(_ runtimeserializer.CodecFactory) []any {
+	return []any{
+		func(in *apiextensionsv1.JSON, c randfill.Continue) {
+			in.Raw = []byte(strconv.Quote(c.String(0)))
+		},
+		func(in *AddonsSpec, c randfill.Continue) {
+			c.FillNoCustom(in)
+			// Shadowed by the v1alpha1 CoreDNS field once serialized.
+			in.AddonsSpec.CoreDNS = nil
+		},
+		func(in *CoreDNSAddonSpec, c randfill.Continue) {
+			c.FillNoCustom(in)
+			// An empty inlined pointer is not serialized.
+			if in.AddonSpec != nil && in.AddonSpec.ImageRepository == "" && in.AddonSpec.ImageTag == "" {
+				in.AddonSpec = nil
+			}
+		},
+		func(in *StewardControlPlaneStatus, c randfill.Continue) {
+			c.FillNoCustom(in)
+			// Defaulted by the API server.
+			if in.ExternalManagedControlPlane == nil {
+				in.ExternalManagedControlPlane = ptr.To(true)
+			}
+		},
+	}
+}
+
+func newFuzzer() *randfill.Filler {
+	scheme := runtime.NewScheme()
+
+	return fuzzer.FuzzerFor(
+		fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, spokeFuzzerFuncs),
+		rand.NewSource(rand.Int63()), //nolint:gosec
+		runtimeserializer.NewCodecFactory(scheme),
+	)
+}
+
+func fuzzTestFunc(hub conversion.Hub, spoke conversion.Convertible) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		t.Run("spoke-hub-spoke", func(t *testing.T) {
+			g, filler := NewWithT(t), newFuzzer()
+
+			for range fuzzIterations {
+				spokeBefore := spoke.DeepCopyObject().(conversion.Convertible) //nolint:forcetypeassert
+				filler.Fill(spokeBefore)
+
+				hubCopy := hub.DeepCopyObject().(conversion.Hub) //nolint:forcetypeassert
+				g.Expect(spokeBefore.ConvertTo(hubCopy)).To(Succeed())
+
+				spokeAfter := spoke.DeepCopyObject().(conversion.Convertible) //nolint:forcetypeassert
+				g.Expect(spokeAfter.ConvertFrom(hubCopy)).To(Succeed())
+				// The conversion data annotation is required only for the hub-spoke-hub round trip.
+				delete(spokeAfter.(interface{ GetAnnotations() map[string]string }).GetAnnotations(), ConversionDataAnnotation) //nolint:forcetypeassert
+
+				g.Expect(apiequality.Semantic.DeepEqual(spokeBefore, spokeAfter)).To(BeTrue(), cmp.Diff(spokeBefore, spokeAfter))
+			}
+		})
+
+		t.Run("hub-spoke-hub", func(t *testing.T) {
+			g, filler := NewWithT(t), newFuzzer()
+
+			for range fuzzIterations {
+				hubBefore := hub.DeepCopyObject().(conversion.Hub) //nolint:forcetypeassert
+				filler.Fill(hubBefore)
+
+				spokeCopy := spoke.DeepCopyObject().(conversion.Convertible) //nolint:forcetypeassert
+				g.Expect(spokeCopy.ConvertFrom(hubBefore)).To(Succeed())
+
+				hubAfter := hub.DeepCopyObject().(conversion.Hub) //nolint:forcetypeassert
+				g.Expect(spokeCopy.ConvertTo(hubAfter)).To(Succeed())
+
+				g.Expect(apiequality.Semantic.DeepEqual(hubBefore, hubAfter)).To(BeTrue(), cmp.Diff(hubBefore, hubAfter))
+			}
+		})
+	}
+}
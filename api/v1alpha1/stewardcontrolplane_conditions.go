@@ -6,12 +6,24 @@ package v1alpha1
 type StewardControlPlaneConditionType string
 
 var (
-	FoundExternalClusterReferenceConditionType  StewardControlPlaneConditionType = "FoundExternalReferenceClient"
-	TenantControlPlaneCreatedConditionType      StewardControlPlaneConditionType = "TenantControlPlaneCreated"
-	TenantControlPlaneAddressReadyConditionType StewardControlPlaneConditionType = "TenantControlPlaneAddressReady"
-	ControlPlaneEndpointPatchedConditionType    StewardControlPlaneConditionType = "ControlPlaneEndpointPatched"
-	InfrastructureClusterPatchedConditionType   StewardControlPlaneConditionType = "InfrastructureClusterPatched"
-	StewardControlPlaneInitializedConditionType StewardControlPlaneConditionType = "StewardControlPlaneIsInitialized"
-	StewardControlPlaneReadyConditionType       StewardControlPlaneConditionType = "StewardControlPlaneIsReady"
-	KubeadmResourcesCreatedReadyConditionType   StewardControlPlaneConditionType = "KubeadmResourcesCreated"
+	FoundExternalClusterReferenceConditionType   StewardControlPlaneConditionType = "FoundExternalReferenceClient"
+	TenantControlPlaneCreatedConditionType       StewardControlPlaneConditionType = "TenantControlPlaneCreated"
+	KubernetesVersionUpgradeAllowedConditionType StewardControlPlaneConditionType = "KubernetesVersionUpgradeAllowed"
+	TenantControlPlaneAddressReadyConditionType  StewardControlPlaneConditionType = "TenantControlPlaneAddressReady"
+	ControlPlaneEndpointPatchedConditionType     StewardControlPlaneConditionType = "ControlPlaneEndpointPatched"
+	InfrastructureClusterPatchedConditionType    StewardControlPlaneConditionType = "InfrastructureClusterPatched"
+	StewardControlPlaneInitializedConditionType  StewardControlPlaneConditionType = "StewardControlPlaneIsInitialized"
+	StewardControlPlaneReadyConditionType        StewardControlPlaneConditionType = "StewardControlPlaneIsReady"
+	KubeadmResourcesCreatedReadyConditionType    StewardControlPlaneConditionType = "KubeadmResourcesCreated"
+)
+
+// Conditions defined by the Cluster API v1beta2 control plane contract,
+// derived from the TenantControlPlane status.
+var (
+	AvailableConditionType   StewardControlPlaneConditionType = "Available"
+	RollingOutConditionType  StewardControlPlaneConditionType = "RollingOut"
+	ScalingUpConditionType   StewardControlPlaneConditionType = "ScalingUp"
+	ScalingDownConditionType StewardControlPlaneConditionType = "ScalingDown"
+	DeletingConditionType    StewardControlPlaneConditionType = "Deleting"
+	PausedConditionType      StewardControlPlaneConditionType = "Paused"
 )
@@ -229,8 +229,23 @@ type ExternalClusterReference struct {
 	DeploymentNamespace string `json:"deploymentNamespace"`
 }
 
+// StewardControlPlaneInitializationStatus provides observations of the StewardControlPlane initialization process,
+// according to the Cluster API v1beta2 contract.
+type StewardControlPlaneInitializationStatus struct {
+	// ControlPlaneInitialized is true when the TenantControlPlane is initialized and able to accept requests.
+	// +optional
+	ControlPlaneInitialized *bool `json:"controlPlaneInitialized,omitempty"`
+}
+
 // StewardControlPlaneStatus defines the observed state of StewardControlPlane.
 type StewardControlPlaneStatus struct {
+	// Initialization provides observations of the StewardControlPlane initialization process,
+	// it supersedes the Initialized field according to the Cluster API v1beta2 contract.
+	// +optional
+	Initialization *StewardControlPlaneInitializationStatus `json:"initialization,omitempty"`
+	// ObservedGeneration is the latest generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The TenantControlPlane has completed initialization.
 	Initialized bool `json:"initialized"`
 	// The Steward Control Plane is ready to link Cluster API with the Tenant Control Plane.
@@ -246,6 +261,13 @@ type StewardControlPlaneStatus struct {
 	UnavailableReplicas int32 `json:"unavailableReplicas"`
 	// Total number of non-terminated Pods targeted by this control plane that have the desired template spec.
 	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// Total number of available TenantControlPlane instances, according to the Cluster API v1beta2 contract.
+	// +optional
+	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+	// Total number of TenantControlPlane instances running the desired specification,
+	// according to the Cluster API v1beta2 contract.
+	// +optional
+	UpToDateReplicas *int32 `json:"upToDateReplicas,omitempty"`
 	// ExternalManagedControlPlane indicates to Cluster API that the Control Plane
 	// is externally managed by Steward.
 	// +kubebuilder:default=true
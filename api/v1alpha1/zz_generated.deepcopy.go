@@ -394,6 +394,26 @@ func (in *StewardControlPlaneFields) DeepCopy() *StewardControlPlaneFields {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneInitializationStatus) DeepCopyInto(out *StewardControlPlaneInitializationStatus) {
+	*out = *in
+	if in.ControlPlaneInitialized != nil {
+		in, out := &in.ControlPlaneInitialized, &out.ControlPlaneInitialized
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneInitializationStatus.
+func (in *StewardControlPlaneInitializationStatus) DeepCopy() *StewardControlPlaneInitializationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneInitializationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StewardControlPlaneList) DeepCopyInto(out *StewardControlPlaneList) {
 	*out = *in
@@ -451,6 +471,21 @@ func (in *StewardControlPlaneSpec) DeepCopy() *StewardControlPlaneSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StewardControlPlaneStatus) DeepCopyInto(out *StewardControlPlaneStatus) {
 	*out = *in
+	if in.Initialization != nil {
+		in, out := &in.Initialization, &out.Initialization
+		*out = new(StewardControlPlaneInitializationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailableReplicas != nil {
+		in, out := &in.AvailableReplicas, &out.AvailableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpToDateReplicas != nil {
+		in, out := &in.UpToDateReplicas, &out.UpToDateReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ExternalManagedControlPlane != nil {
 		in, out := &in.ExternalManagedControlPlane, &out.ExternalManagedControlPlane
 		*out = new(bool)
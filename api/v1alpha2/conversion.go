@@ -0,0 +1,10 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+// Hub marks StewardControlPlane as a conversion hub.
+func (*StewardControlPlane) Hub() {}
+
+// Hub marks StewardControlPlaneTemplate as a conversion hub.
+func (*StewardControlPlaneTemplate) Hub() {}
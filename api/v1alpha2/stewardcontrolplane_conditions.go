@@ -0,0 +1,37 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+type StewardControlPlaneConditionType string
+
+var (
+	FoundExternalClusterReferenceConditionType     StewardControlPlaneConditionType = "FoundExternalReferenceClient"
+	ExternalClusterReferenceHealthyConditionType   StewardControlPlaneConditionType = "ExternalClusterReferenceHealthy"
+	DeploymentNamespaceReadyConditionType          StewardControlPlaneConditionType = "DeploymentNamespaceReady"
+	TenantControlPlaneMigratedConditionType        StewardControlPlaneConditionType = "TenantControlPlaneMigrated"
+	TenantControlPlaneCreatedConditionType         StewardControlPlaneConditionType = "TenantControlPlaneCreated"
+	KubernetesVersionUpgradeAllowedConditionType   StewardControlPlaneConditionType = "KubernetesVersionUpgradeAllowed"
+	TenantControlPlaneAddressReadyConditionType    StewardControlPlaneConditionType = "TenantControlPlaneAddressReady"
+	ControlPlaneEndpointPatchedConditionType       StewardControlPlaneConditionType = "ControlPlaneEndpointPatched"
+	InfrastructureClusterPatchedConditionType      StewardControlPlaneConditionType = "InfrastructureClusterPatched"
+	StewardControlPlaneInitializedConditionType    StewardControlPlaneConditionType = "StewardControlPlaneIsInitialized"
+	StewardControlPlaneReadyConditionType          StewardControlPlaneConditionType = "StewardControlPlaneIsReady"
+	KubeadmResourcesCreatedReadyConditionType      StewardControlPlaneConditionType = "KubeadmResourcesCreated"
+	ClusterSecretsReplicatedConditionType          StewardControlPlaneConditionType = "ClusterSecretsReplicated"
+	RemediationAllowedConditionType                StewardControlPlaneConditionType = "RemediationAllowed"
+	CertificatesNotExpiringConditionType           StewardControlPlaneConditionType = "CertificatesNotExpiring"
+	CertificateAuthoritySyncedConditionType        StewardControlPlaneConditionType = "CertificateAuthoritySynced"
+	CertificateAuthorityKeyReplicatedConditionType StewardControlPlaneConditionType = "CertificateAuthorityKeyReplicated"
+)
+
+// Conditions defined by the Cluster API v1beta2 control plane contract,
+// derived from the TenantControlPlane status.
+var (
+	AvailableConditionType   StewardControlPlaneConditionType = "Available"
+	RollingOutConditionType  StewardControlPlaneConditionType = "RollingOut"
+	ScalingUpConditionType   StewardControlPlaneConditionType = "ScalingUp"
+	ScalingDownConditionType StewardControlPlaneConditionType = "ScalingDown"
+	DeletingConditionType    StewardControlPlaneConditionType = "Deleting"
+	PausedConditionType      StewardControlPlaneConditionType = "Paused"
+)
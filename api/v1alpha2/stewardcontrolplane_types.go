@@ -0,0 +1,649 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ControlPlaneComponent allows the customization for the given component of the control plane.
+type ControlPlaneComponent struct {
+	ExtraVolumeMounts []corev1.VolumeMount        `json:"extraVolumeMounts,omitempty"`
+	ExtraArgs         []string                    `json:"extraArgs,omitempty"`
+	Resources         corev1.ResourceRequirements `json:"resources,omitempty"`
+	// In combination with the container registry, it can override the component container image.
+	// With no value, the default images will be used.
+	// +kubebuilder:validation:MinLength=1
+	ContainerImageName string `json:"containerImageName,omitempty"`
+}
+
+// KineComponent allows the customization for the kine component of the control plane.
+// Available only if Steward is running using Kine as backing storage.
+type KineComponent struct {
+	ExtraArgs []string                    `json:"extraArgs,omitempty"`
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type IngressComponent struct {
+	// Defines the Ingress Class for the Ingress object.
+	ClassName string `json:"className,omitempty"`
+	// Defines the hostname for the Ingress object.
+	// When using an Ingress object the FQDN is automatically added to the Certificate SANs.
+	// +kubebuilder:required
+	// +kubebuilder:validation:MinLength=1
+	Hostname string `json:"hostname"`
+	// ControllerType specifies the ingress controller type for automatic TLS passthrough configuration.
+	// Supported values: "haproxy", "nginx", "traefik", "generic"
+	// - haproxy: Uses haproxy.org/ssl-passthrough annotation
+	// - nginx: Uses nginx.ingress.kubernetes.io/ssl-passthrough annotation
+	// - traefik: Creates IngressRouteTCP instead of standard Ingress
+	// - generic: No automatic annotations, use extraAnnotations for custom configuration
+	// If not specified, defaults to "generic".
+	// +kubebuilder:validation:Enum=haproxy;nginx;traefik;generic
+	// +optional
+	ControllerType string `json:"controllerType,omitempty"`
+	// Defines the extra labels for the Ingress object.
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+	// Defines the extra annotations for the Ingress object.
+	// Useful if you need to define TLS/SSL passthrough, or other Ingress Controller-specific options.
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+// GatewayComponent configures Gateway API exposure for the control plane.
+// When specified, Steward creates a TLSRoute to expose the tenant API server
+// through a Gateway resource, enabling L4 TLS passthrough routing.
+type GatewayComponent struct {
+	// ParentRefs defines the Gateway parent references for TLS routing.
+	// Do not specify port or sectionName; these are set automatically by Steward.
+	// +optional
+	ParentRefs []gatewayv1.ParentReference `json:"parentRefs,omitempty"`
+	// Hostname is used as the TLSRoute hostname for Gateway API routing.
+	// When using a Gateway the hostname is automatically added to the Certificate SANs.
+	// +kubebuilder:required
+	// +kubebuilder:validation:MinLength=1
+	Hostname string `json:"hostname"`
+	// ExtraLabels defines extra labels for the TLSRoute object.
+	// +optional
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+	// ExtraAnnotations defines extra annotations for the TLSRoute object.
+	// +optional
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+// LoadBalancerConfig is used when the StewardControlPlane is exposed using a LoadBalancer service type.
+type LoadBalancerConfig struct {
+	// LoadBalancerSourceRanges restricts the IP ranges that can access
+	// the LoadBalancer type Service. This field defines a list of IP
+	// address ranges (in CIDR format) that are allowed to access the service.
+	// If left empty, the service will allow traffic from all IP ranges (0.0.0.0/0).
+	// This feature is useful for restricting access to API servers or services
+	// to specific networks for security purposes.
+	// Example: {"192.168.1.0/24", "10.0.0.0/8"}
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+	// Specify the LoadBalancer class in case of multiple load balancer implementations.
+	// Field supported only for Tenant Control Plane instances exposed using a LoadBalancer Service.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="LoadBalancerClass is immutable"
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerConfig) || !has(self.loadBalancerConfig.loadBalancerSourceRanges) || (size(self.loadBalancerConfig.loadBalancerSourceRanges) == 0 || self.serviceType == 'LoadBalancer')", message="LoadBalancerSourceRanges are supported only with LoadBalancer service type"
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerConfig) || !has(self.loadBalancerConfig.loadBalancerClass) || self.serviceType == 'LoadBalancer'", message="LoadBalancerClass is supported only with LoadBalancer service type"
+// +kubebuilder:validation:XValidation:rule="self.serviceType != 'LoadBalancer' || (oldSelf.serviceType != 'LoadBalancer' && self.serviceType == 'LoadBalancer') || !has(self.loadBalancerConfig) || has(self.loadBalancerConfig) && has(self.loadBalancerConfig.loadBalancerClass) == has(oldSelf.loadBalancerConfig.loadBalancerClass)",message="LoadBalancerClass cannot be set or unset at runtime"
+// +kubebuilder:validation:XValidation:rule="!(has(self.ingress) && has(self.gateway))",message="using both ingress and gateway is not supported"
+
+type NetworkComponent struct {
+	// Optional configuration for the LoadBalancer service that exposes the Steward control plane.
+	LoadBalancerConfig *LoadBalancerConfig `json:"loadBalancerConfig,omitempty"`
+	// When specified, the StewardControlPlane will be reachable using an Ingress object
+	// deployed in the management cluster.
+	Ingress *IngressComponent `json:"ingress,omitempty"`
+	// When specified, the StewardControlPlane will be reachable using a Gateway API TLSRoute
+	// deployed in the management cluster.
+	// +optional
+	Gateway *GatewayComponent `json:"gateway,omitempty"`
+	// +kubebuilder:default="LoadBalancer"
+	ServiceType stewardv1alpha1.ServiceType `json:"serviceType,omitempty"`
+	// This field can be used in case of pre-assigned address, such as a VIP,
+	// helping when serviceType is NodePort.
+	ServiceAddress     string            `json:"serviceAddress,omitempty"`
+	ServiceLabels      map[string]string `json:"serviceLabels,omitempty"`
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+	// Configure additional Subject Address Names for the kube-apiserver certificate,
+	// useful if the TenantControlPlane is going to be exposed behind a FQDN with NAT.
+	CertSANs []string `json:"certSANs,omitempty"` //nolint:tagliatelle
+	// DNSServiceIPs contains the DNS Service IPs.
+	// If the CoreDNS addon is specified, its DNSServiceIPs will be used instead.
+	// When set to an empty slice, Steward will automatically inflect it from the Service CIDR.
+	DNSServiceIPs []string `json:"dnsServiceIPs,omitempty"`
+}
+
+// AddonsSpec defines the enabled addons and their features:
+// an addon is enabled when the corresponding field is not nil.
+type AddonsSpec struct {
+	// Enables the DNS addon in the Tenant Cluster.
+	CoreDNS *CoreDNSAddonSpec `json:"coreDNS,omitempty"` //nolint:tagliatelle
+	// Enables the Konnectivity addon in the Tenant Cluster, required if the worker nodes are in a different network.
+	Konnectivity *stewardv1alpha1.KonnectivitySpec `json:"konnectivity,omitempty"`
+	// Enables the kube-proxy addon in the Tenant Cluster.
+	KubeProxy *stewardv1alpha1.AddonSpec `json:"kubeProxy,omitempty"`
+	// Enables the tcp-proxy addon in the Tenant Cluster.
+	// +optional
+	TCPProxy *stewardv1alpha1.TCPProxySpec `json:"tcpProxy,omitempty"`
+	// Configures the immutable OS worker nodes bootstrap.
+	// +optional
+	WorkerBootstrap *stewardv1alpha1.WorkerBootstrapSpec `json:"workerBootstrap,omitempty"`
+}
+
+type CoreDNSAddonSpec struct {
+	stewardv1alpha1.AddonSpec `json:",inline"`
+	// DNSServiceIPs contains the CoreDNS Service IPs.
+	// When set to an empty slice, Steward will automatically inflect it from the Service CIDR.
+	DNSServiceIPs []string `json:"dnsServiceIPs,omitempty"`
+}
+
+// StewardControlPlaneSpec defines the desired state of StewardControlPlane.
+type StewardControlPlaneSpec struct {
+	StewardControlPlaneFields `json:",inline"`
+	// ControlPlaneEndpoint propagates the endpoint the Kubernetes API Server managed by Steward is located.
+	ControlPlaneEndpoint capiv1beta1.APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+	// Number of desired replicas for the given TenantControlPlane.
+	// Defaults to 2.
+	// +kubebuilder:default=2
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Version defines the desired Kubernetes version.
+	Version string `json:"version"`
+}
+
+type DeploymentComponent struct {
+	NodeSelector     map[string]string `json:"nodeSelector,omitempty"`
+	RuntimeClassName string            `json:"runtimeClassName,omitempty"`
+	// AdditionalMetadata refers to the additional labels and annotations attached
+	// to the resulting Deployment managed by Steward.
+	AdditionalMetadata stewardv1alpha1.AdditionalMetadata `json:"additionalMetadata,omitempty"`
+	// PodAdditionalMetadata defines the additional labels and annotations that must be attached
+	// to the resulting Pods managed by the Deployment.
+	PodAdditionalMetadata     stewardv1alpha1.AdditionalMetadata `json:"podAdditionalMetadata,omitempty"`
+	ServiceAccountName        string                             `json:"serviceAccountName,omitempty"`
+	Strategy                  appsv1.DeploymentStrategy          `json:"strategy,omitempty"`
+	Affinity                  *corev1.Affinity                   `json:"affinity,omitempty"`
+	Tolerations               []corev1.Toleration                `json:"tolerations,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint  `json:"topologySpreadConstraints,omitempty"`
+	ExtraInitContainers       []corev1.Container                 `json:"extraInitContainers,omitempty"`
+	ExtraContainers           []corev1.Container                 `json:"extraContainers,omitempty"`
+	ExtraVolumes              []corev1.Volume                    `json:"extraVolumes,omitempty"`
+	// ExternalClusterReference allows defining the target Cluster where the Tenant Control Plane components must be deployed.
+	// When this value is nil, the Cluster API management cluster will be used as a target.
+	// The ExternalClusterReference feature gate must be enabled with one of the available flags.
+	ExternalClusterReference *ExternalClusterReference `json:"externalClusterReference,omitempty"`
+}
+
+type StewardControlPlaneFields struct {
+	// The Steward DataStore to use for the given TenantControlPlane.
+	// Retrieve the list of the allowed ones by issuing "kubectl get datastores.steward.butlerlabs.dev".
+	DataStoreName string `json:"dataStoreName,omitempty"`
+	// DataStoreSchema allows to specify the name of the database (for relational DataStores) or the key prefix (for etcd)
+	DataStoreSchema string `json:"dataStoreSchema,omitempty"`
+	// DataStoreUsername allows to specify the username of the database (for relational DataStores). This
+	// value is optional and immutable. Note that Steward currently doesn't ensure that DataStoreUsername values are unique. It's up
+	// to the user to avoid clashes between different TenantControlPlanes. If not set upon creation, Steward will default the
+	// DataStoreUsername by concatenating the namespace and name of the TenantControlPlane.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="changing the dataStoreUsername is not supported"
+	DataStoreUsername string `json:"dataStoreUsername,omitempty"`
+	// The addons that must be managed by Steward, such as CoreDNS, kube-proxy, and konnectivity.
+	Addons AddonsSpec `json:"addons,omitempty"`
+	// List of the admission controllers to configure for the TenantControlPlane kube-apiserver.
+	// By default, no admission controllers are enabled, refer to the desired Kubernetes version.
+	//
+	// More info: https://kubernetes.io/docs/reference/access-authn-authz/admission-controllers/
+	AdmissionControllers stewardv1alpha1.AdmissionControllers `json:"admissionControllers,omitempty"`
+	// Override the container registry used to pull the components image.
+	// Helpful if running in an air-gapped environment.
+	// +kubebuilder:default="registry.k8s.io"
+	ContainerRegistry string `json:"registry,omitempty"`
+
+	ControllerManager ControlPlaneComponent `json:"controllerManager,omitempty"`
+	APIServer         ControlPlaneComponent `json:"apiServer,omitempty"`
+	Scheduler         ControlPlaneComponent `json:"scheduler,omitempty"`
+	Kine              KineComponent         `json:"kine,omitempty"`
+	// Configure the Kubelet options, such as the preferred address types, or the expected cgroupfs.
+	// +kubebuilder:default={preferredAddressTypes:{"InternalIP","ExternalIP","Hostname"},cgroupfs:"systemd"}
+	Kubelet stewardv1alpha1.KubeletSpec `json:"kubelet,omitempty"`
+	// Configure how the TenantControlPlane should be exposed.
+	// +kubebuilder:default={serviceType:"LoadBalancer"}
+	Network NetworkComponent `json:"network,omitempty"`
+	// Configure how the TenantControlPlane Deployment object should be configured.
+	Deployment DeploymentComponent `json:"deployment,omitempty"`
+	// Remediation enables the remediation of the TenantControlPlane when stuck in a non-ready version status.
+	// When this value is nil, no remediation is performed.
+	Remediation *RemediationSpec `json:"remediation,omitempty"`
+	// UserKubeconfig enables the generation of the <cluster>-user-kubeconfig Secret, a non-admin kubeconfig for the end users:
+	// the permissions must be granted in the workload cluster with RBAC, according to the configured identity.
+	// When this value is nil, the Secret is not generated.
+	UserKubeconfig *UserKubeconfigSpec `json:"userKubeconfig,omitempty"`
+	// ClusterSecrets enables the replication of the additional TenantControlPlane key pairs
+	// into the Secrets defined by the Cluster API contract.
+	ClusterSecrets ClusterSecretsSpec `json:"clusterSecrets,omitempty"`
+	// KubeconfigServer rewrites the server of the kubeconfig Secrets, otherwise replicated as is from the Steward
+	// admin kubeconfig, which could point to an address reachable only from the management cluster.
+	// When this value is nil, no rewrite is performed.
+	KubeconfigServer *KubeconfigServerSpec `json:"kubeconfigServer,omitempty"`
+	// CertificateAuthority configures the source of truth of the TenantControlPlane Certificate Authority.
+	// Defaulted as a whole, the objects persisted before its introduction get the default source too.
+	// +kubebuilder:default={}
+	CertificateAuthority CertificateAuthoritySpec `json:"certificateAuthority,omitempty"`
+}
+
+// CertificateAuthoritySource defines the source of truth of the TenantControlPlane Certificate Authority.
+// +kubebuilder:validation:Enum=Steward;Cluster
+type CertificateAuthoritySource string
+
+const (
+	// StewardCertificateAuthoritySource lets Steward generate the Certificate Authority, replicated into the <cluster>-ca Secret.
+	StewardCertificateAuthoritySource CertificateAuthoritySource = "Steward"
+	// ClusterCertificateAuthoritySource seeds the Certificate Authority pre-created in the <cluster>-ca Secret into Steward,
+	// such as an intermediate one issued by a corporate Certificate Authority.
+	ClusterCertificateAuthoritySource CertificateAuthoritySource = "Cluster"
+)
+
+// CertificateAuthoritySpec defines how the TenantControlPlane Certificate Authority is managed.
+// The unset source is the default one, it can't be changed once set.
+// +kubebuilder:validation:XValidation:rule="(has(self.source) ? self.source : 'Steward') == (has(oldSelf.source) ? oldSelf.source : 'Steward')",message="changing the certificate authority source is not supported"
+type CertificateAuthoritySpec struct {
+	// Source is the source of truth of the Certificate Authority: with the Cluster one, the <cluster>-ca Secret must
+	// provide the tls.crt and tls.key values, and it's kept in sync with Steward for the whole TenantControlPlane lifecycle.
+	// +kubebuilder:default="Steward"
+	Source CertificateAuthoritySource `json:"source,omitempty"`
+	// Replication defines the values of the Steward generated Certificate Authority replicated into the <cluster>-ca Secret.
+	// With CertificateOnly, the private key is withheld and replaced by a placeholder one, unrelated to the Certificate Authority:
+	// the user kubeconfig client certificates are issued by the kubernetes.io/kube-apiserver-client signer of the workload cluster,
+	// and the kubelet ones of the worker nodes joined by the kubeadm bootstrap provider by the kube-apiserver-client-kubelet one.
+	// The other flows requiring the private key are not supported.
+	// +kubebuilder:default="KeyPair"
+	Replication CertificateAuthorityReplication `json:"replication,omitempty"`
+}
+
+// CertificateAuthorityReplication defines the Certificate Authority values replicated into the <cluster>-ca Secret.
+// +kubebuilder:validation:Enum=KeyPair;CertificateOnly
+type CertificateAuthorityReplication string
+
+const (
+	// KeyPairCertificateAuthorityReplication replicates both the certificate and the private key.
+	KeyPairCertificateAuthorityReplication CertificateAuthorityReplication = "KeyPair"
+	// CertificateOnlyCertificateAuthorityReplication replicates the certificate, withholding the private key.
+	CertificateOnlyCertificateAuthorityReplication CertificateAuthorityReplication = "CertificateOnly"
+)
+
+// KubeconfigServerSpec defines the server of the kubeconfig Secrets.
+type KubeconfigServerSpec struct {
+	// URL overrides the server, such as https://api.tenant.example.com:6443: when empty, the advertised
+	// Control Plane endpoint is used, taking into account the Ingress and Gateway hostnames.
+	// The Kubernetes API Server certificate must be valid for the given host.
+	URL string `json:"url,omitempty"`
+}
+
+// ClusterSecretsSpec selects the TenantControlPlane key pairs replicated into the Cluster API Secrets,
+// required by the tooling expecting them, such as the workload identity setups reading the service account signing key.
+//
+// More info: https://cluster-api.sigs.k8s.io/developer/architecture/controllers/cluster.html#secrets
+type ClusterSecretsSpec struct {
+	// ServiceAccount replicates the service account signing key pair into the <cluster>-sa Secret.
+	ServiceAccount bool `json:"serviceAccount,omitempty"`
+	// FrontProxy replicates the front proxy Certificate Authority into the <cluster>-proxy Secret.
+	FrontProxy bool `json:"frontProxy,omitempty"`
+	// Etcd replicates the DataStore Certificate Authority into the <cluster>-etcd Secret:
+	// only the certificate is available, since the DataStore private key is shared among the TenantControlPlanes.
+	// The DataStore without a TLS configuration has no Certificate Authority, as reported by the ClusterSecretsReplicated condition.
+	Etcd bool `json:"etcd,omitempty"`
+}
+
+// UserKubeconfigSpec defines the identity of the user kubeconfig, authenticated with a client certificate
+// issued by the TenantControlPlane Certificate Authority, or with an exec-based plugin.
+type UserKubeconfigSpec struct {
+	// Username is the name of the user, used as the client certificate Common Name.
+	// +kubebuilder:default="capi-user"
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username,omitempty"`
+	// Groups are the groups of the user, used as the client certificate Organizations.
+	// The system:masters group is not allowed since granting cluster-admin permissions.
+	Groups []string `json:"groups,omitempty"`
+	// CertificateValidity is the validity of the client certificate, which is renewed once two thirds of it elapsed.
+	// +kubebuilder:default="8760h"
+	CertificateValidity *metav1.Duration `json:"certificateValidity,omitempty"`
+	// Exec configures an exec-based credential plugin, such as an OIDC one, used in place of the client certificate.
+	Exec *UserKubeconfigExec `json:"exec,omitempty"`
+}
+
+// UserKubeconfigExec mirrors the kubeconfig exec-based credential plugin configuration.
+type UserKubeconfigExec struct {
+	// APIVersion is the preferred input version of the ExecCredential.
+	// +kubebuilder:default="client.authentication.k8s.io/v1"
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Command to execute.
+	// +kubebuilder:validation:MinLength=1
+	Command string `json:"command"`
+	// Arguments to pass to the command when executing it.
+	Args []string `json:"args,omitempty"`
+	// Env defines additional environment variables to expose to the process.
+	Env []UserKubeconfigExecEnvVar `json:"env,omitempty"`
+	// InstallHint is printed when the plugin executable is not found.
+	InstallHint string `json:"installHint,omitempty"`
+	// ProvideClusterInfo determines whether to provide the cluster information to the plugin.
+	ProvideClusterInfo bool `json:"provideClusterInfo,omitempty"`
+	// InteractiveMode determines the relationship between the plugin and the standard input.
+	// +kubebuilder:default="IfAvailable"
+	// +kubebuilder:validation:Enum=Never;IfAvailable;Always
+	InteractiveMode string `json:"interactiveMode,omitempty"`
+}
+
+// UserKubeconfigExecEnvVar is an environment variable exposed to the exec-based credential plugin.
+type UserKubeconfigExecEnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// RemediationAction is the action performed to remediate a non-ready TenantControlPlane.
+// +kubebuilder:validation:Enum=RollingRestart;ReconcileTrigger
+type RemediationAction string
+
+const (
+	// RollingRestartRemediationAction restarts the TenantControlPlane Deployment pods.
+	RollingRestartRemediationAction RemediationAction = "RollingRestart"
+	// ReconcileTriggerRemediationAction triggers the Steward reconciliation of the TenantControlPlane.
+	ReconcileTriggerRemediationAction RemediationAction = "ReconcileTrigger"
+)
+
+// RemediationSpec defines the remediation policy of a TenantControlPlane stuck in a non-ready version status.
+type RemediationSpec struct {
+	// Timeout is the duration the TenantControlPlane can stay in a non-ready version status before being remediated,
+	// not accounting the operations in progress, such as the provisioning or an upgrade.
+	// +kubebuilder:default="10m"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// RetryPeriod is the minimum duration between two remediation attempts.
+	// +kubebuilder:default="5m"
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
+	// MaxRetries is the maximum number of remediation attempts for a non-ready TenantControlPlane,
+	// once reached the RemediationAllowed condition is reported as false.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// Action is the remediation performed on the TenantControlPlane.
+	// +kubebuilder:default="RollingRestart"
+	Action RemediationAction `json:"action,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.clusterRef) ? !has(self.kubeconfigSecretName) && !has(self.kubeconfigSecretKey) : has(self.kubeconfigSecretName) && has(self.kubeconfigSecretKey)",message="either clusterRef, or kubeconfigSecretName and kubeconfigSecretKey must be specified"
+type ExternalClusterReference struct {
+	// The Secret object containing the kubeconfig used to interact with the remote cluster that will host
+	// the Tenant Control Plane resources generated by the Control Plane Provider.
+	// +kubebuilder:validation:MinLength=1
+	KubeconfigSecretName string `json:"kubeconfigSecretName,omitempty"`
+	// The key used to extract the kubeconfig from the specified Secret.
+	// +kubebuilder:validation:MinLength=1
+	KubeconfigSecretKey string `json:"kubeconfigSecretKey,omitempty"`
+	// When ExternalClusterReferenceCrossNamespace is enabled allows specifying a different Namespace where the kubeconfig can be retrieved.
+	// With ExternalClusterReference this value can be left empty since the StewardControlPlane object Namespace will be used.
+	KubeconfigSecretNamespace string `json:"kubeconfigSecretNamespace,omitempty"`
+	// ClusterRef references the Cluster API Cluster hosting the Tenant Control Plane resources, as an alternative
+	// to the kubeconfig Secret: its <name>-kubeconfig Secret is used, once the Cluster is ready.
+	ClusterRef *ExternalClusterReferenceClusterRef `json:"clusterRef,omitempty"`
+	// The Namespace where the resulting TenantControlPlane must be deployed to.
+	DeploymentNamespace string `json:"deploymentNamespace"`
+	// ManagedNamespace enables the lifecycle management of the deployment Namespace in the external cluster:
+	// it's created if missing, and deleted along with the last StewardControlPlane deployed to it.
+	// When empty, the deployment Namespace must be already available.
+	ManagedNamespace *ManagedNamespaceSpec `json:"managedNamespace,omitempty"`
+	// Naming defines how the TenantControlPlane deployed to the external cluster is named.
+	// When empty, the UID naming strategy is used.
+	Naming *RemoteNamingSpec `json:"naming,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=UID;NamespaceName;Template
+type RemoteNamingStrategy string
+
+var (
+	// UIDRemoteNamingStrategy names the remote TenantControlPlane kcp-<StewardControlPlane UID>.
+	UIDRemoteNamingStrategy RemoteNamingStrategy = "UID"
+	// NamespaceNameRemoteNamingStrategy names the remote TenantControlPlane <StewardControlPlane namespace>-<StewardControlPlane name>-<hash>,
+	// where the hash of the namespaced name prevents the collisions, and the prefix is truncated to fit 63 characters.
+	NamespaceNameRemoteNamingStrategy RemoteNamingStrategy = "NamespaceName"
+	// TemplateRemoteNamingStrategy names the remote TenantControlPlane according to a Go template.
+	TemplateRemoteNamingStrategy RemoteNamingStrategy = "Template"
+)
+
+// RemoteNamingSpec defines the naming of the TenantControlPlane deployed to the external cluster.
+// An existing TenantControlPlane labelled with the StewardControlPlane name and namespace is adopted, regardless of its name:
+// changing the naming strategy doesn't rename an already deployed TenantControlPlane.
+//
+// +kubebuilder:validation:XValidation:rule="self.strategy != 'Template' || has(self.template)",message="the template is required with the Template strategy"
+type RemoteNamingSpec struct {
+	// +kubebuilder:default=UID
+	Strategy RemoteNamingStrategy `json:"strategy,omitempty"`
+	// Template is the Go template used with the Template strategy,
+	// such as {{ .Namespace }}-{{ .Name }}: the available fields are Name, Namespace, UID, and ClusterName.
+	Template string `json:"template,omitempty"`
+}
+
+// ManagedNamespaceSpec defines the desired state of the deployment Namespace created by the Control Plane Provider.
+type ManagedNamespaceSpec struct {
+	// Labels applied to the Namespace.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations applied to the Namespace.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ResourceQuota defines the ResourceQuota enforced in the Namespace.
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	// LimitRange defines the LimitRange enforced in the Namespace.
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty"`
+}
+
+// ExternalClusterReferenceClusterRef references a Cluster API Cluster.
+type ExternalClusterReferenceClusterRef struct {
+	// Name of the Cluster.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the Cluster: when empty, the StewardControlPlane object Namespace will be used.
+	// A different Namespace requires the ExternalClusterReferenceCrossNamespace feature gate.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// StewardControlPlaneInitializationStatus provides observations of the StewardControlPlane initialization process,
+// according to the Cluster API v1beta2 contract.
+type StewardControlPlaneInitializationStatus struct {
+	// ControlPlaneInitialized is true when the TenantControlPlane is initialized and able to accept requests.
+	// +optional
+	ControlPlaneInitialized *bool `json:"controlPlaneInitialized,omitempty"`
+}
+
+// RemediationStatus tracks the remediation attempts of a non-ready TenantControlPlane.
+type RemediationStatus struct {
+	// NotReadySince is the time the TenantControlPlane has been observed in a non-ready version status,
+	// nil when the TenantControlPlane is ready.
+	// +optional
+	NotReadySince *metav1.Time `json:"notReadySince,omitempty"`
+	// RetryCount is the number of remediation attempts since the TenantControlPlane is not ready.
+	RetryCount int32 `json:"retryCount"`
+	// LastRemediationTime is the time of the last remediation attempt.
+	// +optional
+	LastRemediationTime *metav1.Time `json:"lastRemediationTime,omitempty"`
+	// LastAction is the action performed with the last remediation attempt.
+	// +optional
+	LastAction RemediationAction `json:"lastAction,omitempty"`
+}
+
+// RotateCertificatesAnnotation requests Steward to rotate the TenantControlPlane certificates, such as the API Server
+// and the admin kubeconfig ones: it's removed by the controller once the rotation is completed.
+const RotateCertificatesAnnotation = "steward.butlerlabs.dev/rotate-certificates"
+
+// ForceDeleteAnnotation removes the StewardControlPlane finalizer when set to true, although the TenantControlPlanes deployed to the
+// external clusters are not yet deleted, such as when the external cluster is no longer reachable: the remote resources are orphaned.
+const ForceDeleteAnnotation = "steward.butlerlabs.dev/force-delete"
+
+// CertificatesStatus reports the expiration of the TenantControlPlane certificates.
+type CertificatesStatus struct {
+	// CertificateAuthorityNotAfter is the expiration time of the TenantControlPlane Certificate Authority.
+	// +optional
+	CertificateAuthorityNotAfter *metav1.Time `json:"certificateAuthorityNotAfter,omitempty"`
+	// AdminClientNotAfter is the expiration time of the admin kubeconfig client certificate.
+	// +optional
+	AdminClientNotAfter *metav1.Time `json:"adminClientNotAfter,omitempty"`
+	// Rotation tracks the last rotation of the certificates requested with the annotation.
+	// +optional
+	Rotation *CertificatesRotationStatus `json:"rotation,omitempty"`
+}
+
+// CertificatesRotationStatus tracks the progress of the certificates rotation performed by Steward.
+type CertificatesRotationStatus struct {
+	// RequestedAt is the time the rotation has been requested to Steward.
+	RequestedAt metav1.Time `json:"requestedAt"`
+	// CompletedAt is the time all the certificates have been rotated, nil while in progress.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Total is the number of certificates to rotate.
+	Total int32 `json:"total"`
+	// Pending is the number of certificates not yet rotated by Steward.
+	Pending int32 `json:"pending"`
+}
+
+// HostingStatus reports the cluster hosting the TenantControlPlane.
+type HostingStatus struct {
+	// ExternalClusterReference of the hosting cluster, empty for the management cluster.
+	// +optional
+	ExternalClusterReference *ExternalClusterReference `json:"externalClusterReference,omitempty"`
+	// TenantControlPlaneName is the name of the TenantControlPlane in the hosting cluster.
+	TenantControlPlaneName string `json:"tenantControlPlaneName"`
+	// TenantControlPlaneNamespace is the namespace of the TenantControlPlane in the hosting cluster.
+	TenantControlPlaneNamespace string `json:"tenantControlPlaneNamespace"`
+}
+
+// +kubebuilder:validation:Enum=SeedingSecrets;ProvisioningDestination;SwitchingEndpoint;DeletingSource
+type MigrationPhase string
+
+var (
+	// SeedingSecretsMigrationPhase copies the certificates and DataStore credentials of the source TenantControlPlane to the destination.
+	SeedingSecretsMigrationPhase MigrationPhase = "SeedingSecrets"
+	// ProvisioningDestinationMigrationPhase waits for the destination TenantControlPlane to be ready.
+	ProvisioningDestinationMigrationPhase MigrationPhase = "ProvisioningDestination"
+	// SwitchingEndpointMigrationPhase advertises the destination TenantControlPlane kubeconfig, the endpoint being unchanged.
+	SwitchingEndpointMigrationPhase MigrationPhase = "SwitchingEndpoint"
+	// DeletingSourceMigrationPhase deletes the source TenantControlPlane, preserving the DataStore content.
+	DeletingSourceMigrationPhase MigrationPhase = "DeletingSource"
+)
+
+// MigrationStatus tracks the migration of the TenantControlPlane between hosting clusters,
+// allowing to resume it upon a controller restart.
+type MigrationStatus struct {
+	// Source is the hosting of the migrated TenantControlPlane.
+	Source HostingStatus `json:"source"`
+	// Destination is the hosting the TenantControlPlane is migrated to.
+	Destination HostingStatus `json:"destination"`
+	// Phase is the current step of the migration.
+	Phase MigrationPhase `json:"phase"`
+	// StartTime is the time the migration started.
+	StartTime metav1.Time `json:"startTime"`
+	// DataStoreName is the DataStore of the source TenantControlPlane, shared with the destination one.
+	// +optional
+	DataStoreName string `json:"dataStoreName,omitempty"`
+	// DataStoreSchema is the schema of the source TenantControlPlane, shared with the destination one.
+	// +optional
+	DataStoreSchema string `json:"dataStoreSchema,omitempty"`
+	// DataStoreUsername is the username of the source TenantControlPlane, shared with the destination one.
+	// +optional
+	DataStoreUsername string `json:"dataStoreUsername,omitempty"`
+}
+
+// StewardControlPlaneStatus defines the observed state of StewardControlPlane.
+type StewardControlPlaneStatus struct {
+	// Initialization provides observations of the StewardControlPlane initialization process,
+	// it supersedes the Initialized field according to the Cluster API v1beta2 contract.
+	// +optional
+	Initialization *StewardControlPlaneInitializationStatus `json:"initialization,omitempty"`
+	// ObservedGeneration is the latest generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// The TenantControlPlane has completed initialization.
+	Initialized bool `json:"initialized"`
+	// The Steward Control Plane is ready to link Cluster API with the Tenant Control Plane.
+	Ready bool `json:"ready"`
+
+	// Total number of fully running and ready control plane instances.
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// Total number of non-terminated control plane instances.
+	Replicas int32  `json:"replicas"`
+	Selector string `json:"selector"`
+	// Total number of unavailable TenantControlPlane instances targeted by this control plane,
+	// equal to the desired number of control plane instances - ready instances.
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// Total number of non-terminated Pods targeted by this control plane that have the desired template spec.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// Total number of available TenantControlPlane instances, according to the Cluster API v1beta2 contract.
+	// +optional
+	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
+	// Total number of TenantControlPlane instances running the desired specification,
+	// according to the Cluster API v1beta2 contract.
+	// +optional
+	UpToDateReplicas *int32 `json:"upToDateReplicas,omitempty"`
+	// ExternalManagedControlPlane indicates to Cluster API that the Control Plane
+	// is externally managed by Steward, it's always set by the controller.
+	ExternalManagedControlPlane bool `json:"externalManagedControlPlane"`
+	// Share the failed process of the StewardControlPlane provider which wasn't able to complete the reconciliation for the given resource.
+	FailureReason string `json:"failureReason,omitempty"`
+	// The error message, if available, for the failing reconciliation.
+	FailureMessage string `json:"failureMessage,omitempty"`
+	// Certificates reports the expiration of the TenantControlPlane certificates, and their rotation.
+	// +optional
+	Certificates *CertificatesStatus `json:"certificates,omitempty"`
+	// Remediation tracks the remediation attempts, available when a remediation policy is set.
+	// +optional
+	Remediation *RemediationStatus `json:"remediation,omitempty"`
+	// Hosting reports the cluster hosting the TenantControlPlane.
+	// +optional
+	Hosting *HostingStatus `json:"hosting,omitempty"`
+	// PreviousHosting reports the cluster which hosted the TenantControlPlane before the last placement change,
+	// such as when the ExternalClusterReference is added or removed.
+	// +optional
+	PreviousHosting *HostingStatus `json:"previousHosting,omitempty"`
+	// Migration tracks the in-progress migration of the TenantControlPlane between hosting clusters.
+	// +optional
+	Migration *MigrationStatus `json:"migration,omitempty"`
+	// String representing the minimum Kubernetes version for the control plane machines in the cluster.
+	Version    string             `json:"version"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:categories=cluster-api;steward,shortName=stcp
+//+kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+//+kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The desired Kubernetes version"
+//+kubebuilder:printcolumn:name="Initialized",type="boolean",JSONPath=".status.initialized",description="Check if the Steward Control Plane has been initialized"
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Check if the Steward Control Plane is up and running"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age"
+
+// StewardControlPlane is the Schema for the stewardcontrolplanes API.
+type StewardControlPlane struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StewardControlPlaneSpec   `json:"spec,omitempty"`
+	Status StewardControlPlaneStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// StewardControlPlaneList contains a list of StewardControlPlane.
+type StewardControlPlaneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StewardControlPlane `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StewardControlPlane{}, &StewardControlPlaneList{})
+}
@@ -0,0 +1,992 @@
+//go:build !ignore_autogenerated
+
+// Copyright 2023 Clastix Labs
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"github.com/butlerdotdev/steward/api/v1alpha1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonsSpec) DeepCopyInto(out *AddonsSpec) {
+	*out = *in
+	if in.CoreDNS != nil {
+		in, out := &in.CoreDNS, &out.CoreDNS
+		*out = new(CoreDNSAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(v1alpha1.KonnectivitySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(v1alpha1.AddonSpec)
+		**out = **in
+	}
+	if in.TCPProxy != nil {
+		in, out := &in.TCPProxy, &out.TCPProxy
+		*out = new(v1alpha1.TCPProxySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerBootstrap != nil {
+		in, out := &in.WorkerBootstrap, &out.WorkerBootstrap
+		*out = new(v1alpha1.WorkerBootstrapSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsSpec.
+func (in *AddonsSpec) DeepCopy() *AddonsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthoritySpec) DeepCopyInto(out *CertificateAuthoritySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthoritySpec.
+func (in *CertificateAuthoritySpec) DeepCopy() *CertificateAuthoritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthoritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesRotationStatus) DeepCopyInto(out *CertificatesRotationStatus) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesRotationStatus.
+func (in *CertificatesRotationStatus) DeepCopy() *CertificatesRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesStatus) DeepCopyInto(out *CertificatesStatus) {
+	*out = *in
+	if in.CertificateAuthorityNotAfter != nil {
+		in, out := &in.CertificateAuthorityNotAfter, &out.CertificateAuthorityNotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.AdminClientNotAfter != nil {
+		in, out := &in.AdminClientNotAfter, &out.AdminClientNotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(CertificatesRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesStatus.
+func (in *CertificatesStatus) DeepCopy() *CertificatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSecretsSpec) DeepCopyInto(out *ClusterSecretsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSecretsSpec.
+func (in *ClusterSecretsSpec) DeepCopy() *ClusterSecretsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSecretsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponent) DeepCopyInto(out *ControlPlaneComponent) {
+	*out = *in
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponent.
+func (in *ControlPlaneComponent) DeepCopy() *ControlPlaneComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSAddonSpec) DeepCopyInto(out *CoreDNSAddonSpec) {
+	*out = *in
+	out.AddonSpec = in.AddonSpec
+	if in.DNSServiceIPs != nil {
+		in, out := &in.DNSServiceIPs, &out.DNSServiceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSAddonSpec.
+func (in *CoreDNSAddonSpec) DeepCopy() *CoreDNSAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentComponent) DeepCopyInto(out *DeploymentComponent) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.AdditionalMetadata.DeepCopyInto(&out.AdditionalMetadata)
+	in.PodAdditionalMetadata.DeepCopyInto(&out.PodAdditionalMetadata)
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraInitContainers != nil {
+		in, out := &in.ExtraInitContainers, &out.ExtraInitContainers
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraContainers != nil {
+		in, out := &in.ExtraContainers, &out.ExtraContainers
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalClusterReference != nil {
+		in, out := &in.ExternalClusterReference, &out.ExternalClusterReference
+		*out = new(ExternalClusterReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentComponent.
+func (in *DeploymentComponent) DeepCopy() *DeploymentComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalClusterReference) DeepCopyInto(out *ExternalClusterReference) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(ExternalClusterReferenceClusterRef)
+		**out = **in
+	}
+	if in.ManagedNamespace != nil {
+		in, out := &in.ManagedNamespace, &out.ManagedNamespace
+		*out = new(ManagedNamespaceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(RemoteNamingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalClusterReference.
+func (in *ExternalClusterReference) DeepCopy() *ExternalClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalClusterReferenceClusterRef) DeepCopyInto(out *ExternalClusterReferenceClusterRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalClusterReferenceClusterRef.
+func (in *ExternalClusterReferenceClusterRef) DeepCopy() *ExternalClusterReferenceClusterRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalClusterReferenceClusterRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayComponent) DeepCopyInto(out *GatewayComponent) {
+	*out = *in
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]apisv1.ParentReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraAnnotations != nil {
+		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayComponent.
+func (in *GatewayComponent) DeepCopy() *GatewayComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostingStatus) DeepCopyInto(out *HostingStatus) {
+	*out = *in
+	if in.ExternalClusterReference != nil {
+		in, out := &in.ExternalClusterReference, &out.ExternalClusterReference
+		*out = new(ExternalClusterReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostingStatus.
+func (in *HostingStatus) DeepCopy() *HostingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressComponent) DeepCopyInto(out *IngressComponent) {
+	*out = *in
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraAnnotations != nil {
+		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressComponent.
+func (in *IngressComponent) DeepCopy() *IngressComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KineComponent) DeepCopyInto(out *KineComponent) {
+	*out = *in
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KineComponent.
+func (in *KineComponent) DeepCopy() *KineComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(KineComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigServerSpec) DeepCopyInto(out *KubeconfigServerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigServerSpec.
+func (in *KubeconfigServerSpec) DeepCopy() *KubeconfigServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedNamespaceSpec) DeepCopyInto(out *ManagedNamespaceSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(v1.ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRange != nil {
+		in, out := &in.LimitRange, &out.LimitRange
+		*out = new(v1.LimitRangeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNamespaceSpec.
+func (in *ManagedNamespaceSpec) DeepCopy() *ManagedNamespaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedNamespaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationStatus) DeepCopyInto(out *MigrationStatus) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Destination.DeepCopyInto(&out.Destination)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationStatus.
+func (in *MigrationStatus) DeepCopy() *MigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkComponent) DeepCopyInto(out *NetworkComponent) {
+	*out = *in
+	if in.LoadBalancerConfig != nil {
+		in, out := &in.LoadBalancerConfig, &out.LoadBalancerConfig
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(GatewayComponent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceLabels != nil {
+		in, out := &in.ServiceLabels, &out.ServiceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CertSANs != nil {
+		in, out := &in.CertSANs, &out.CertSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSServiceIPs != nil {
+		in, out := &in.DNSServiceIPs, &out.DNSServiceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkComponent.
+func (in *NetworkComponent) DeepCopy() *NetworkComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationSpec) DeepCopyInto(out *RemediationSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryPeriod != nil {
+		in, out := &in.RetryPeriod, &out.RetryPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationSpec.
+func (in *RemediationSpec) DeepCopy() *RemediationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationStatus) DeepCopyInto(out *RemediationStatus) {
+	*out = *in
+	if in.NotReadySince != nil {
+		in, out := &in.NotReadySince, &out.NotReadySince
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRemediationTime != nil {
+		in, out := &in.LastRemediationTime, &out.LastRemediationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationStatus.
+func (in *RemediationStatus) DeepCopy() *RemediationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteNamingSpec) DeepCopyInto(out *RemoteNamingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteNamingSpec.
+func (in *RemoteNamingSpec) DeepCopy() *RemoteNamingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteNamingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlane) DeepCopyInto(out *StewardControlPlane) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlane.
+func (in *StewardControlPlane) DeepCopy() *StewardControlPlane {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlane)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StewardControlPlane) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneFields) DeepCopyInto(out *StewardControlPlaneFields) {
+	*out = *in
+	in.Addons.DeepCopyInto(&out.Addons)
+	if in.AdmissionControllers != nil {
+		in, out := &in.AdmissionControllers, &out.AdmissionControllers
+		*out = make(v1alpha1.AdmissionControllers, len(*in))
+		copy(*out, *in)
+	}
+	in.ControllerManager.DeepCopyInto(&out.ControllerManager)
+	in.APIServer.DeepCopyInto(&out.APIServer)
+	in.Scheduler.DeepCopyInto(&out.Scheduler)
+	in.Kine.DeepCopyInto(&out.Kine)
+	in.Kubelet.DeepCopyInto(&out.Kubelet)
+	in.Network.DeepCopyInto(&out.Network)
+	in.Deployment.DeepCopyInto(&out.Deployment)
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(RemediationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserKubeconfig != nil {
+		in, out := &in.UserKubeconfig, &out.UserKubeconfig
+		*out = new(UserKubeconfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.ClusterSecrets = in.ClusterSecrets
+	if in.KubeconfigServer != nil {
+		in, out := &in.KubeconfigServer, &out.KubeconfigServer
+		*out = new(KubeconfigServerSpec)
+		**out = **in
+	}
+	out.CertificateAuthority = in.CertificateAuthority
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneFields.
+func (in *StewardControlPlaneFields) DeepCopy() *StewardControlPlaneFields {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneInitializationStatus) DeepCopyInto(out *StewardControlPlaneInitializationStatus) {
+	*out = *in
+	if in.ControlPlaneInitialized != nil {
+		in, out := &in.ControlPlaneInitialized, &out.ControlPlaneInitialized
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneInitializationStatus.
+func (in *StewardControlPlaneInitializationStatus) DeepCopy() *StewardControlPlaneInitializationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneInitializationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneList) DeepCopyInto(out *StewardControlPlaneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StewardControlPlane, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneList.
+func (in *StewardControlPlaneList) DeepCopy() *StewardControlPlaneList {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StewardControlPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneSpec) DeepCopyInto(out *StewardControlPlaneSpec) {
+	*out = *in
+	in.StewardControlPlaneFields.DeepCopyInto(&out.StewardControlPlaneFields)
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneSpec.
+func (in *StewardControlPlaneSpec) DeepCopy() *StewardControlPlaneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneStatus) DeepCopyInto(out *StewardControlPlaneStatus) {
+	*out = *in
+	if in.Initialization != nil {
+		in, out := &in.Initialization, &out.Initialization
+		*out = new(StewardControlPlaneInitializationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AvailableReplicas != nil {
+		in, out := &in.AvailableReplicas, &out.AvailableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpToDateReplicas != nil {
+		in, out := &in.UpToDateReplicas, &out.UpToDateReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(CertificatesStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(RemediationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hosting != nil {
+		in, out := &in.Hosting, &out.Hosting
+		*out = new(HostingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousHosting != nil {
+		in, out := &in.PreviousHosting, &out.PreviousHosting
+		*out = new(HostingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneStatus.
+func (in *StewardControlPlaneStatus) DeepCopy() *StewardControlPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneTemplate) DeepCopyInto(out *StewardControlPlaneTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneTemplate.
+func (in *StewardControlPlaneTemplate) DeepCopy() *StewardControlPlaneTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StewardControlPlaneTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneTemplateList) DeepCopyInto(out *StewardControlPlaneTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StewardControlPlaneTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneTemplateList.
+func (in *StewardControlPlaneTemplateList) DeepCopy() *StewardControlPlaneTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StewardControlPlaneTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneTemplateResource) DeepCopyInto(out *StewardControlPlaneTemplateResource) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneTemplateResource.
+func (in *StewardControlPlaneTemplateResource) DeepCopy() *StewardControlPlaneTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StewardControlPlaneTemplateSpec) DeepCopyInto(out *StewardControlPlaneTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StewardControlPlaneTemplateSpec.
+func (in *StewardControlPlaneTemplateSpec) DeepCopy() *StewardControlPlaneTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StewardControlPlaneTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserKubeconfigExec) DeepCopyInto(out *UserKubeconfigExec) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]UserKubeconfigExecEnvVar, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserKubeconfigExec.
+func (in *UserKubeconfigExec) DeepCopy() *UserKubeconfigExec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserKubeconfigExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserKubeconfigExecEnvVar) DeepCopyInto(out *UserKubeconfigExecEnvVar) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserKubeconfigExecEnvVar.
+func (in *UserKubeconfigExecEnvVar) DeepCopy() *UserKubeconfigExecEnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(UserKubeconfigExecEnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserKubeconfigSpec) DeepCopyInto(out *UserKubeconfigSpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificateValidity != nil {
+		in, out := &in.CertificateValidity, &out.CertificateValidity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(UserKubeconfigExec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserKubeconfigSpec.
+func (in *UserKubeconfigSpec) DeepCopy() *UserKubeconfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserKubeconfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -6,11 +6,14 @@ package controllers
 import (
 	"context"
 	"strings"
+	"time"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
@@ -23,16 +26,27 @@ import (
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
-	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/indexers"
 )
 
+// stewardSecretsSelector matches the Secrets labelled with the TenantControlPlane name, such as the ones generated by Steward:
+// the remote cluster manager caches only them, rather than every Secret of the hosting cluster.
+var stewardSecretsSelector = labels.NewSelector().Add(func() labels.Requirement {
+	requirement, _ := labels.NewRequirement(stewardTenantControlPlaneNameLabel, selection.Exists, nil)
+
+	return *requirement
+}())
+
 type ExternalClusterReferenceReconciler struct {
-	Client         client.Client
-	Store          externalclusterreference.Store
-	TriggerChannel chan event.GenericEvent
+	Client  client.Client
+	Store   externalclusterreference.Store
+	Trigger *externalclusterreference.Trigger
+	// restartChannel enqueues back the Secret of a failed manager, once it can be restarted.
+	restartChannel chan event.GenericEvent
 }
 
 //nolint:funlen,cyclop
@@ -53,7 +67,7 @@ func (r *ExternalClusterReferenceReconciler) Reconcile(ctx context.Context, req
 	var keys []string
 
 	for _, key := range externalclusterreference.GenerateKeyNameFromSecret(&secret) {
-		var scpList v1alpha1.StewardControlPlaneList
+		var scpList v1alpha2.StewardControlPlaneList
 
 		if err := r.Client.List(ctx, &scpList, client.MatchingFields{indexers.ExternalClusterReferenceStewardControlPlaneField: key}); err != nil {
 			log.Error(err, "unable to use indexer", "key", key)
@@ -74,21 +88,37 @@ func (r *ExternalClusterReferenceReconciler) Reconcile(ctx context.Context, req
 		keys = append(keys, key)
 	}
 
+	var result ctrl.Result
+
 	for _, key := range keys {
 		if _, found := r.Store.Get(key, secret.ResourceVersion); found {
 			continue
 		}
+		// A failed manager is restarted with backoff, unless the configuration changed in the meanwhile.
+		health, known := r.Store.Health(key)
 
-		if !r.Store.Stop(key) {
-			log.Info("new configuration, loading manager")
-		} else {
+		switch {
+		case known && health.Failed() && health.ResourceVersion == secret.ResourceVersion:
+			if wait := time.Until(health.NextRestart); wait > 0 {
+				result = requeueAfter(result, wait)
+
+				continue
+			}
+
+			log.Info("restarting failed manager", "key", key, "failures", health.Failures)
+		case r.Store.Stop(key):
 			log.Info("configuration seems changed, restarting manager")
+		default:
+			log.Info("new configuration, loading manager")
 		}
 
 		cfg, cfgErr := clientcmd.RESTConfigFromKubeConfig(secret.Data[strings.Split(key, "/")[2]])
 		if cfgErr != nil {
 			log.Error(cfgErr, "cannot generate REST config from Secret content", "key", key)
 
+			r.Store.MarkFailed(key, secret.ResourceVersion, cfgErr)
+			r.notifyStewardControlPlanes(ctx, key)
+
 			return ctrl.Result{}, cfgErr //nolint:wrapcheck
 		}
 
@@ -99,47 +129,98 @@ func (r *ExternalClusterReferenceReconciler) Reconcile(ctx context.Context, req
 				ByObject: map[client.Object]cache.ByObject{
 					// Reduce memory overhead by only caching watched resources.
 					&stewardv1alpha1.TenantControlPlane{}: {},
+					&corev1.Secret{}:                      {Label: stewardSecretsSelector},
 				},
 			},
 		})
 		if err != nil {
 			log.Error(err, "cannot generate manager")
 
+			r.Store.MarkFailed(key, secret.ResourceVersion, err)
+			r.notifyStewardControlPlanes(ctx, key)
+
 			return ctrl.Result{}, err //nolint:wrapcheck
 		}
 
-		if err = (&PushStewardChange{ParentClient: r.Client, Client: mgr.GetClient(), TriggerChannel: r.TriggerChannel}).SetupWithManager(mgr); err != nil {
+		if err = (&PushStewardChange{ParentClient: r.Client, Client: mgr.GetClient(), Trigger: r.Trigger}).SetupWithManager(mgr); err != nil {
 			log.Error(err, "unable to create controller", "controller", "PushStewardChange")
 
+			r.Store.MarkFailed(key, secret.ResourceVersion, err)
+			r.notifyStewardControlPlanes(ctx, key)
+
 			return ctrl.Result{}, err
 		}
 
 		mgrCtx, cancelFn := context.WithCancel(ctx)
-		go r.startManager(mgrCtx, mgr, key)
-
 		r.Store.Add(key, secret.ResourceVersion, mgr, cancelFn)
+
+		go r.startManager(ctx, mgrCtx, mgr, key, secret)
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
-func (r *ExternalClusterReferenceReconciler) startManager(ctx context.Context, mgr ctrl.Manager, name string) {
-	if mgrErr := mgr.Start(ctx); mgrErr != nil {
-		ctrllog.FromContext(ctx).Error(mgrErr, "manager cannot be started, external cluster reference could not work")
+// startManager runs the remote cluster manager, tracking its health: once failed, it's restarted with backoff.
+// The manager context is cancelled once it's stopped, the parent one once the controller is shutting down.
+func (r *ExternalClusterReferenceReconciler) startManager(ctx, mgrCtx context.Context, mgr ctrl.Manager, name string, secret corev1.Secret) {
+	log := ctrllog.FromContext(mgrCtx)
+
+	go func() {
+		if mgr.GetCache().WaitForCacheSync(mgrCtx) {
+			r.Store.MarkCacheSynced(name)
+			r.notifyStewardControlPlanes(mgrCtx, name)
+		}
+	}()
+
+	r.Store.MarkStarted(name)
 
-		r.Store.Stop(name)
+	mgrErr := mgr.Start(mgrCtx)
+	if mgrErr == nil {
+		return
+	}
+
+	delay, current := r.Store.MarkFailed(name, secret.ResourceVersion, mgrErr)
+	if !current {
+		log.Info("superseded manager failed, ignoring", "error", mgrErr.Error())
+
+		return
+	}
+
+	log.Error(mgrErr, "manager cannot be started, external cluster reference could not work", "restartAfter", delay)
+	// The parent context is still valid, the manager has not been stopped on purpose.
+	r.notifyStewardControlPlanes(ctx, name)
+
+	time.AfterFunc(delay, func() {
+		select {
+		case r.restartChannel <- event.GenericEvent{Object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secret.Name, Namespace: secret.Namespace}}}:
+		case <-ctx.Done():
+		}
+	})
+}
+
+func (r *ExternalClusterReferenceReconciler) notifyStewardControlPlanes(ctx context.Context, name string) {
+	var scpList v1alpha2.StewardControlPlaneList
+
+	if err := r.Client.List(ctx, &scpList, client.MatchingFields{indexers.ExternalClusterReferenceStewardControlPlaneField: name}); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "unable to use indexer", "key", name)
+
+		return
+	}
+
+	for _, scp := range scpList.Items {
+		r.Trigger.Notify(types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace})
 	}
 }
 
 func (r *ExternalClusterReferenceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.restartChannel = make(chan event.GenericEvent)
+
 	//nolint:wrapcheck
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1.Secret{}).
-		Watches(&v1alpha1.StewardControlPlane{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
-			scp := object.(*v1alpha1.StewardControlPlane) //nolint:forcetypeassert
-			if scp.Spec.Deployment.ExternalClusterReference == nil {
-				return nil
-			}
+		WatchesRawSource(source.Channel(r.restartChannel, &handler.EnqueueRequestForObject{})).
+		Watches(&v1alpha2.StewardControlPlane{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+			scp := object.(*v1alpha2.StewardControlPlane) //nolint:forcetypeassert
 
 			var requests []reconcile.Request
 
@@ -157,22 +238,26 @@ func (r *ExternalClusterReferenceReconciler) SetupWithManager(mgr ctrl.Manager)
 		Complete(r)
 }
 
-func (r *ExternalClusterReferenceReconciler) getSecretFromStewardControlPlaneReferences(ctx context.Context, scp *v1alpha1.StewardControlPlane) []corev1.Secret {
-	var secretList corev1.SecretList
+func (r *ExternalClusterReferenceReconciler) getSecretFromStewardControlPlaneReferences(ctx context.Context, scp *v1alpha2.StewardControlPlane) []corev1.Secret {
+	var secrets []corev1.Secret
 
-	val := externalclusterreference.GenerateKeyNameFromSteward(scp)
+	for _, val := range externalclusterreference.GenerateKeyNamesFromSteward(scp) {
+		var secretList corev1.SecretList
 
-	if err := r.Client.List(ctx, &secretList, client.MatchingFields{indexers.ExternalClusterReferenceSecretField: val}); err != nil {
-		return nil
+		if err := r.Client.List(ctx, &secretList, client.MatchingFields{indexers.ExternalClusterReferenceSecretField: val}); err != nil {
+			return nil
+		}
+
+		secrets = append(secrets, secretList.Items...)
 	}
 
-	return secretList.Items
+	return secrets
 }
 
 type PushStewardChange struct {
-	ParentClient   client.Client
-	Client         client.Client
-	TriggerChannel chan event.GenericEvent
+	ParentClient client.Client
+	Client       client.Client
+	Trigger      *externalclusterreference.Trigger
 }
 
 func (p *PushStewardChange) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -186,27 +271,13 @@ func (p *PushStewardChange) Reconcile(ctx context.Context, request reconcile.Req
 		return reconcile.Result{}, err //nolint:wrapcheck
 	}
 
-	value := externalclusterreference.ParseStewardControlPlaneUIDFromTenantControlPlane(tcp)
-	if value == "" {
+	key, ok := externalclusterreference.ParseStewardControlPlaneFromTenantControlPlane(tcp)
+	if !ok {
 		return reconcile.Result{}, nil
 	}
 
-	var scpList v1alpha1.StewardControlPlaneList
-
-	if err := p.ParentClient.List(ctx, &scpList, client.MatchingFields{indexers.StewardControlPlaneUIDField: value}); err != nil {
-		return reconcile.Result{}, err //nolint:wrapcheck
-	}
-
-	for _, scp := range scpList.Items {
-		p.TriggerChannel <- event.GenericEvent{
-			Object: &v1alpha1.StewardControlPlane{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      scp.Name,
-					Namespace: scp.Namespace,
-				},
-			},
-		}
-	}
+	// Never blocking the remote manager, the notifications of the same StewardControlPlane are merged.
+	p.Trigger.Notify(key)
 
 	return reconcile.Result{}, nil
 }
@@ -218,5 +289,6 @@ func (p *PushStewardChange) SetupWithManager(mgr ctrl.Manager) error {
 			SkipNameValidation: ptr.To(true),
 		}).
 		For(&stewardv1alpha1.TenantControlPlane{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(p.stewardSourceSecretToTenantControlPlane)).
 		Complete(p)
 }
@@ -0,0 +1,78 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+// stewardSourceSecretOwner returns the TenantControlPlane using the given Secret as source-of-truth for the replicated
+// admin kubeconfig or Certificate Authority, nil if the Secret is not one of them.
+func stewardSourceSecretOwner(ctx context.Context, reader client.Reader, object client.Object) *stewardv1alpha1.TenantControlPlane {
+	secret, ok := object.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	owner := metav1.GetControllerOf(secret)
+	if owner == nil || owner.Kind != "TenantControlPlane" {
+		return nil
+	}
+
+	if gv, err := schema.ParseGroupVersion(owner.APIVersion); err != nil || gv.Group != stewardv1alpha1.GroupVersion.Group {
+		return nil
+	}
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := reader.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: secret.Namespace}, &tcp); err != nil {
+		return nil
+	}
+
+	if secret.Name != tcp.Status.KubeConfig.Admin.SecretName && secret.Name != tcp.Status.Certificates.CA.SecretName {
+		return nil
+	}
+
+	return &tcp
+}
+
+// stewardSourceSecretToStewardControlPlane enqueues the StewardControlPlane owning the TenantControlPlane
+// deployed in the management cluster, once one of its source Secrets changes, such as upon a certificate rotation.
+func (r *StewardControlPlaneReconciler) stewardSourceSecretToStewardControlPlane(ctx context.Context, object client.Object) []reconcile.Request {
+	tcp := stewardSourceSecretOwner(ctx, r.client, object)
+	if tcp == nil {
+		return nil
+	}
+
+	owner := metav1.GetControllerOf(tcp)
+	if owner == nil || owner.Kind != "StewardControlPlane" {
+		return nil
+	}
+
+	if gv, err := schema.ParseGroupVersion(owner.APIVersion); err != nil || gv.Group != scpv1alpha2.GroupVersion.Group {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: owner.Name, Namespace: tcp.Namespace}}}
+}
+
+// stewardSourceSecretToTenantControlPlane enqueues the TenantControlPlane deployed in the external cluster,
+// once one of its source Secrets changes: the StewardControlPlane is then notified with the trigger.
+func (p *PushStewardChange) stewardSourceSecretToTenantControlPlane(ctx context.Context, object client.Object) []reconcile.Request {
+	tcp := stewardSourceSecretOwner(ctx, p.Client, object)
+	if tcp == nil {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: tcp.Name, Namespace: tcp.Namespace}}}
+}
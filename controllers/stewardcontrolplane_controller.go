@@ -10,17 +10,23 @@ import (
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	goerrors "github.com/pkg/errors"
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/component-base/featuregate"
+	"k8s.io/utils/ptr"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
-	"sigs.k8s.io/cluster-api/util/predicates"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,11 +35,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
-	"sigs.k8s.io/controller-runtime/pkg/source"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	scpv1alpha1 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/features"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/indexers"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/runtimehooks"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/upgrade"
 )
 
 // StewardControlPlaneReconciler reconciles a StewardControlPlane object.
@@ -42,8 +51,21 @@ type StewardControlPlaneReconciler struct {
 	FeatureGates                  featuregate.FeatureGate
 	MaxConcurrentReconciles       int
 	DynamicInfrastructureClusters sets.Set[string]
-
-	client client.Client
+	// RequeueMinDelay and RequeueMaxDelay are the bounds of the per-object exponential backoff,
+	// used when waiting for changes which are expected to be notified by the watches.
+	RequeueMinDelay time.Duration
+	RequeueMaxDelay time.Duration
+	// CertificatesExpiryThreshold is the duration before the certificates expiration when they're reported as expiring.
+	CertificatesExpiryThreshold time.Duration
+	// ExternalClusterReferenceDeletionTimeout is the duration after which the remote TenantControlPlanes still being deleted
+	// are orphaned, such as when the external cluster is no longer reachable: zero means waiting indefinitely.
+	ExternalClusterReferenceDeletionTimeout time.Duration
+	// RuntimeClient is used to call the Runtime SDK lifecycle hooks, nil when the RuntimeSDK feature gate is disabled.
+	RuntimeClient *runtimehooks.Client
+
+	client   client.Client
+	backoff  workqueue.TypedRateLimiter[types.NamespacedName]
+	recorder record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=stewardcontrolplanes,verbs=get;list;watch;create;update;patch;delete
@@ -59,32 +81,25 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 	log.Info("reconciliation started")
 
 	// Retrieving the StewardControlPlane instance from the request
-	scp := scpv1alpha1.StewardControlPlane{}
+	scp := scpv1alpha2.StewardControlPlane{}
 	if err = r.client.Get(ctx, req.NamespacedName, &scp); err != nil {
 		if errors.IsNotFound(err) {
 			log.Info("resource may have been deleted")
 
+			r.backoff.Forget(req.NamespacedName)
+
 			return ctrl.Result{}, nil
 		}
 
-		log.Error(err, "unable to get scpv1alpha1.StewardControlPlane")
+		log.Error(err, "unable to get scpv1alpha2.StewardControlPlane")
 
 		return ctrl.Result{}, err //nolint:wrapcheck
 	}
 	// The ControlPlane must have an OwnerReference set from the Cluster controller, waiting for this condition:
 	// https://cluster-api.sigs.k8s.io/developer/architecture/controllers/control-plane.html#relationship-to-other-cluster-api-types
-	if len(scp.GetOwnerReferences()) == 0 {
-		log.Info("missing OwnerReference from the Cluster controller, waiting for it")
-
-		return ctrl.Result{}, nil
-	}
-
-	// Retrieving the Cluster information
-	cluster := capiv1beta1.Cluster{}
-	cluster.SetName(scp.GetOwnerReferences()[0].Name)
-	cluster.SetNamespace(scp.GetNamespace())
-
-	if err = r.client.Get(ctx, types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace}, &cluster); err != nil {
+	// The owner is looked up by kind, since further OwnerReferences could be added by other tools.
+	owner, err := util.GetOwnerCluster(ctx, r.client, scp.ObjectMeta)
+	if err != nil {
 		if errors.IsNotFound(err) {
 			log.Info("capiv1beta1.Cluster resource may have been deleted, withdrawing reconciliation")
 
@@ -96,29 +111,51 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{}, err //nolint:wrapcheck
 	}
 
-	// Return early if the object or Cluster is paused.
+	if owner == nil {
+		log.Info("missing OwnerReference from the Cluster controller, waiting for it")
+
+		return ctrl.Result{}, nil
+	}
+	// Retrieving the Cluster information
+	cluster := *owner
+
+	// Return early if the object or Cluster is paused, reporting it as required by the v1beta2 contract.
 	if annotations.IsPaused(&cluster, &scp) {
 		log.Info("Reconciliation is paused for this object")
 
+		if err = r.updateStewardControlPlaneStatus(ctx, &scp, func() {
+			setContractCondition(&scp, scpv1alpha2.PausedConditionType, metav1.Condition{Status: metav1.ConditionTrue, Reason: capiv1beta1.PausedV1Beta2Reason})
+		}); err != nil {
+			log.Error(err, "unable to report scpv1alpha2.StewardControlPlane as paused")
+
+			return ctrl.Result{}, err
+		}
+
 		return ctrl.Result{}, nil
 	}
 
 	// Handling finalizer for external deployment:
 	// in case of ExternalClusterReference the remote TCP must be deleted.
 	if scp.DeletionTimestamp != nil {
-		return ctrl.Result{}, r.handleDeletion(ctx, scp)
+		return r.handleDeletion(ctx, scp)
 	}
 
 	// Extracting conditions, used to update the StewardControlPlane ones upon the end of the reconciliation.
 	conditions := scp.Status.Conditions
+	// The Steward TenantControlPlane resource, used to derive the v1beta2 contract conditions.
+	var tcp *stewardv1alpha1.TenantControlPlane
 
 	defer func() {
 		deferErr := r.updateStewardControlPlaneStatus(ctx, &scp, func() {
 			scp.Status.Conditions = conditions
+			scp.Status.ObservedGeneration = scp.Generation
+			scp.Status.ExternalManagedControlPlane = true
+
+			setContractConditions(&scp, &cluster, tcp)
 		})
 
 		if deferErr != nil {
-			log.Error(err, "unable to update scpv1alpha1.StewardControlPlane conditions")
+			log.Error(err, "unable to update scpv1alpha2.StewardControlPlane conditions")
 		}
 	}()
 	// When ExternalClusterReference feature is enabled, we need to interact with a different API endpoint
@@ -127,11 +164,12 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 	var remoteClient client.Client
 
 	if scp.Spec.Deployment.ExternalClusterReference != nil {
-		TrackConditionType(&conditions, scpv1alpha1.FoundExternalClusterReferenceConditionType, scp.Generation, func() error {
+		TrackConditionType(&conditions, scpv1alpha2.FoundExternalClusterReferenceConditionType, scp.Generation, func() error {
 			remoteClient, err = r.extractRemoteClient(ctx, scp)
 
 			return err
 		})
+		setExternalClusterReferenceHealthCondition(&conditions, scp, r.ExternalClusterReferenceStore)
 
 		if err != nil {
 			log.Error(err, "unable to get remote Client")
@@ -144,12 +182,95 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 
 			return ctrl.Result{}, err
 		}
+		// Creating the deployment Namespace when managed by the provider: a pre-existing one is used as it is,
+		// reporting it with the DeploymentNamespaceReady condition.
+		if scp.Spec.Deployment.ExternalClusterReference.ManagedNamespace != nil {
+			TrackConditionType(&conditions, scpv1alpha2.DeploymentNamespaceReadyConditionType, scp.Generation, func() error {
+				err = r.reconcileDeploymentNamespace(ctx, remoteClient, scp)
+
+				return err
+			})
+
+			if err != nil && !goerrors.Is(err, ErrDeploymentNamespaceNotManaged) {
+				log.Error(err, "unable to reconcile the deployment Namespace")
+
+				return ctrl.Result{}, err
+			}
+		} else {
+			meta.RemoveStatusCondition(&conditions, string(scpv1alpha2.DeploymentNamespaceReadyConditionType))
+		}
+	} else {
+		meta.RemoveStatusCondition(&conditions, string(scpv1alpha2.ExternalClusterReferenceHealthyConditionType))
+		meta.RemoveStatusCondition(&conditions, string(scpv1alpha2.DeploymentNamespaceReadyConditionType))
 	}
-	// Reconciling the Steward TenantControlPlane resource
-	var tcp *stewardv1alpha1.TenantControlPlane
+	// Coordinating with the Runtime SDK lifecycle hooks: the AfterControlPlaneInitialized one is tracked as pending
+	// until the TenantControlPlane is ready, while Kubernetes version upgrades could be blocked by BeforeClusterUpgrade.
+	var result ctrl.Result
+
+	var upgradeRetryAfter time.Duration
+
+	if r.isRuntimeSDKEnabled(cluster) {
+		if !scp.Status.Initialized && !runtimehooks.IsPending(runtimehooksv1.AfterControlPlaneInitialized, &scp) {
+			if err = runtimehooks.MarkAsPending(ctx, r.client, &scp, runtimehooksv1.AfterControlPlaneInitialized); err != nil {
+				log.Error(err, "unable to mark the AfterControlPlaneInitialized hook as pending")
+
+				return ctrl.Result{}, err //nolint:wrapcheck
+			}
+		}
+
+		if upgradeRetryAfter, err = r.reconcileBeforeClusterUpgradeHook(ctx, remoteClient, cluster, &scp); err != nil {
+			log.Error(err, "unable to reconcile the BeforeClusterUpgrade hook")
+
+			return ctrl.Result{}, err
+		}
+
+		result.RequeueAfter = upgradeRetryAfter
+	}
+	// Migrating the TenantControlPlane once the hosting cluster changed: the destination one shares the certificates
+	// and the DataStore of the source one, which is deleted once the destination one is ready and advertised.
+	// Each step is tracked in the status, resuming the migration upon a controller restart.
+	err = r.reconcileMigrationStart(ctx, remoteClient, &scp)
+	setMigrationCondition(&conditions, scp, err)
+
+	switch {
+	case goerrors.Is(err, ErrMigrationRolledBack):
+		log.Info(err.Error())
+
+		err = nil
+	case goerrors.Is(err, ErrEnqueueBack):
+		log.Info(err.Error() + ", enqueuing back")
 
-	TrackConditionType(&conditions, scpv1alpha1.TenantControlPlaneCreatedConditionType, scp.Generation, func() error {
-		tcp, err = r.createOrUpdateTenantControlPlane(ctx, remoteClient, cluster, scp)
+		return r.enqueueBack(req, result), nil
+	case err != nil:
+		log.Error(err, "unable to start the TenantControlPlane migration")
+
+		return ctrl.Result{}, err
+	}
+	// Seeding the provided Certificate Authority before the TenantControlPlane creation, since Steward would generate it otherwise.
+	if isCertificateAuthorityProvided(scp) {
+		TrackConditionType(&conditions, scpv1alpha2.CertificateAuthoritySyncedConditionType, scp.Generation, func() error {
+			err = r.reconcileProvidedCertificateAuthority(ctx, remoteClient, cluster, scp)
+
+			return err
+		})
+
+		if goerrors.Is(err, ErrEnqueueBack) {
+			log.Info(err.Error() + ", enqueuing back")
+
+			return r.enqueueBack(req, result), nil
+		}
+
+		if err != nil {
+			log.Error(err, "unable to seed the provided Certificate Authority")
+
+			return ctrl.Result{}, err
+		}
+	} else {
+		meta.RemoveStatusCondition(&conditions, string(scpv1alpha2.CertificateAuthoritySyncedConditionType))
+	}
+	// Reconciling the Steward TenantControlPlane resource
+	TrackConditionType(&conditions, scpv1alpha2.TenantControlPlaneCreatedConditionType, scp.Generation, func() error {
+		tcp, err = r.createOrUpdateTenantControlPlane(ctx, remoteClient, cluster, scp, upgradeRetryAfter > 0)
 
 		return err
 	})
@@ -159,13 +280,67 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 
 		return ctrl.Result{}, err
 	}
+	// The endpoint of the migrated TenantControlPlane is advertised only once the destination one is ready.
+	if scp.Status.Migration != nil {
+		err = r.reconcileMigrationProvisioning(ctx, cluster, &scp, tcp)
+		setMigrationCondition(&conditions, scp, err)
+
+		if goerrors.Is(err, ErrEnqueueBack) {
+			log.Info(err.Error() + ", enqueuing back")
+
+			return r.enqueueBack(req, result), nil
+		}
+
+		if err != nil {
+			log.Error(err, "unable to track the TenantControlPlane migration")
+
+			return ctrl.Result{}, err
+		}
+	} else if err = r.recordHosting(ctx, &scp, tcp); err != nil {
+		log.Error(err, "unable to report the TenantControlPlane hosting")
+
+		return ctrl.Result{}, err
+	}
+	// Changes to the Kubernetes version violating the skew policy are not applied to the TenantControlPlane:
+	// the dedicated condition gives visibility when the change is driven by the ClusterClass topology,
+	// or when the admission webhooks are not enabled.
+	TrackConditionType(&conditions, scpv1alpha2.KubernetesVersionUpgradeAllowedConditionType, scp.Generation, func() error {
+		if upgradeRetryAfter > 0 {
+			return fmt.Errorf("upgrade to %s is blocked by the BeforeClusterUpgrade hook, retrying in %s", scp.Spec.Version, upgradeRetryAfter)
+		}
+
+		return upgrade.ValidateVersionChange(tcp.Status.Kubernetes.Version.Version, tcp.Spec.Kubernetes.Version, scp.Spec.Version)
+	})
+	// Remediating the TenantControlPlane stuck in a non-ready version status, according to the opt-in policy:
+	// once the attempts are exhausted, the reconciliation continues reporting it with the RemediationAllowed condition.
+	if scp.Spec.Remediation != nil {
+		var remediationRetryAfter time.Duration
+
+		var remediationErr error
+
+		TrackConditionType(&conditions, scpv1alpha2.RemediationAllowedConditionType, scp.Generation, func() error {
+			remediationRetryAfter, remediationErr = r.reconcileRemediation(ctx, remoteClient, &scp, tcp)
+
+			return remediationErr
+		})
+
+		if remediationErr != nil && !goerrors.Is(remediationErr, ErrRemediationExhausted) {
+			log.Error(remediationErr, "unable to remediate the TenantControlPlane")
+
+			return ctrl.Result{}, remediationErr
+		}
+
+		result = requeueAfter(result, remediationRetryAfter)
+	} else {
+		meta.RemoveStatusCondition(&conditions, string(scpv1alpha2.RemediationAllowedConditionType))
+	}
 	// Waiting for the TenantControlPlane address: pay attention!
 	//
 	// This is still a work-in-progress and changing the Control Plane Controller contract.
 	// Due to the given for granted concept that Control Plane and Worker nodes are on the same infrastructure,
 	// we have to change the approach and wait for the advertised Control Plane endpoint, since Steward is offering a
 	// Managed Kubernetes Service, although running as a regular pod.
-	TrackConditionType(&conditions, scpv1alpha1.TenantControlPlaneAddressReadyConditionType, scp.Generation, func() error {
+	TrackConditionType(&conditions, scpv1alpha2.TenantControlPlaneAddressReadyConditionType, scp.Generation, func() error {
 		if len(tcp.Status.ControlPlaneEndpoint) == 0 {
 			err = goerrors.New("Control Plane Endpoint is not yet available since unprocessed by Steward")
 		}
@@ -178,19 +353,19 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 	if err != nil {
 		log.Info(err.Error() + ", enqueuing back")
 
-		return ctrl.Result{RequeueAfter: time.Second}, nil
+		return r.enqueueBack(req, result), nil
 	}
 	// Starting from CAPI v1.8, the ControlPlane provider can set the Control Plane endpoint:
 	// this will make useless the patchCluster function in the future.
 	// More info: https://release-1-8.cluster-api.sigs.k8s.io/developer/providers/control-plane#optional-spec-fields-for-implementations-providing-endpoints
-	TrackConditionType(&conditions, scpv1alpha1.ControlPlaneEndpointPatchedConditionType, scp.Generation, func() error {
+	TrackConditionType(&conditions, scpv1alpha2.ControlPlaneEndpointPatchedConditionType, scp.Generation, func() error {
 		err = r.patchControlPlaneEndpoint(ctx, &scp, tcp.Status.ControlPlaneEndpoint)
 
 		return err
 	})
 
 	if err != nil {
-		log.Error(err, "cannot patch scpv1alpha1.StewardControlPlane")
+		log.Error(err, "cannot patch scpv1alpha2.StewardControlPlane")
 
 		return ctrl.Result{}, err
 	}
@@ -214,7 +389,7 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 	if !r.FeatureGates.Enabled(features.SkipInfraClusterPatch) {
 		// Patching the Infrastructure Cluster:
 		// this will be removed on the upcoming Steward Control Plane versions.
-		TrackConditionType(&conditions, scpv1alpha1.InfrastructureClusterPatchedConditionType, scp.Generation, func() error {
+		TrackConditionType(&conditions, scpv1alpha2.InfrastructureClusterPatchedConditionType, scp.Generation, func() error {
 			err = r.patchCluster(ctx, cluster, &scp, tcp.Status.ControlPlaneEndpoint)
 
 			return err
@@ -230,55 +405,68 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 	// Before continuing, the Cluster object needs some validation, such as:
 	// 1. an assigned Control Plane endpoint
 	// 2. a ready infrastructure
+	// Both changes are notified by the Cluster watch: the per-object backoff only recovers a missed event,
+	// rather than polling every second.
 	if len(cluster.Spec.ControlPlaneEndpoint.Host) == 0 {
 		log.Info("capiv1beta1.Cluster Control Plane endpoint still unprocessed, enqueuing back")
 
-		return ctrl.Result{RequeueAfter: time.Second}, nil
+		return r.enqueueBack(req, result), nil
 	}
 
 	if !cluster.Status.InfrastructureReady {
 		log.Info("capiv1beta1.Cluster infrastructure is not yet ready, enqueuing back")
 
-		return ctrl.Result{RequeueAfter: time.Second}, nil
+		return r.enqueueBack(req, result), nil
 	}
 
 	if tcp.Status.Kubernetes.Version.Status == nil {
 		log.Info("stewardv1alpha1.TenantControlPlane is not yet initialized, enqueuing back")
 
-		return ctrl.Result{RequeueAfter: time.Second}, nil
+		return r.enqueueBack(req, result), nil
 	}
 
 	if *tcp.Status.Kubernetes.Version.Status == stewardv1alpha1.VersionReady && !scp.Status.Initialized {
 		// TenantControlPlane has been initialized
-		TrackConditionType(&conditions, scpv1alpha1.StewardControlPlaneInitializedConditionType, scp.Generation, func() error {
+		TrackConditionType(&conditions, scpv1alpha2.StewardControlPlaneInitializedConditionType, scp.Generation, func() error {
 			err = r.updateStewardControlPlaneStatus(ctx, &scp, func() {
 				scp.Status.Initialized = true
+				scp.Status.Initialization = &scpv1alpha2.StewardControlPlaneInitializationStatus{ControlPlaneInitialized: ptr.To(true)}
 			})
 
 			return err
 		})
 
 		if err != nil {
-			log.Error(err, "unable to set scpv1alpha1.StewardControlPlane as initialized")
+			log.Error(err, "unable to set scpv1alpha2.StewardControlPlane as initialized")
 
 			return ctrl.Result{}, err
 		}
 	}
 
 	if !scp.Status.Initialized {
-		log.Info("scpv1alpha1.StewardControlPlane is not yet initialized, enqueuing back")
+		log.Info("scpv1alpha2.StewardControlPlane is not yet initialized, enqueuing back")
 
-		return ctrl.Result{RequeueAfter: time.Second}, nil
+		return r.enqueueBack(req, result), nil
+	}
+
+	if r.isRuntimeSDKEnabled(cluster) {
+		if err = r.reconcileAfterControlPlaneInitializedHook(ctx, cluster, &scp); err != nil {
+			log.Error(err, "unable to reconcile the AfterControlPlaneInitialized hook")
+
+			return ctrl.Result{}, err
+		}
 	}
 
 	// Updating StewardControlPlane ready status, along with scaling values
-	TrackConditionType(&conditions, scpv1alpha1.StewardControlPlaneInitializedConditionType, scp.Generation, func() error {
+	TrackConditionType(&conditions, scpv1alpha2.StewardControlPlaneInitializedConditionType, scp.Generation, func() error {
 		err = r.updateStewardControlPlaneStatus(ctx, &scp, func() {
 			scp.Status.ReadyReplicas = tcp.Status.Kubernetes.Deployment.ReadyReplicas
 			scp.Status.Replicas = tcp.Status.Kubernetes.Deployment.Replicas
 			scp.Status.Selector = metav1.FormatLabelSelector(&metav1.LabelSelector{MatchLabels: scp.GetLabels()})
 			scp.Status.UnavailableReplicas = tcp.Status.Kubernetes.Deployment.UnavailableReplicas
 			scp.Status.UpdatedReplicas = tcp.Status.Kubernetes.Deployment.UpdatedReplicas
+			scp.Status.AvailableReplicas = ptr.To(tcp.Status.Kubernetes.Deployment.AvailableReplicas)
+			scp.Status.UpToDateReplicas = ptr.To(tcp.Status.Kubernetes.Deployment.UpdatedReplicas)
 			scp.Status.Version = tcp.Status.Kubernetes.Version.Version
 		})
 
@@ -286,22 +474,32 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 	})
 
 	if err != nil {
-		log.Error(err, "unable to report scpv1alpha1.StewardControlPlane status")
+		log.Error(err, "unable to report scpv1alpha2.StewardControlPlane status")
 
 		return ctrl.Result{}, err
 	}
+	// The upgrade completion is notified once the TenantControlPlane reached the desired version.
+	if r.isRuntimeSDKEnabled(cluster) {
+		retryAfter, hookErr := r.reconcileAfterControlPlaneUpgradeHook(ctx, cluster, &scp, tcp)
+		if hookErr != nil {
+			log.Error(hookErr, "unable to reconcile the AfterControlPlaneUpgrade hook")
+
+			return ctrl.Result{}, hookErr
+		}
+
+		result = requeueAfter(result, retryAfter)
+	}
 	// StewardControlPlane must be considered ready before replicating required resources
-	TrackConditionType(&conditions, scpv1alpha1.StewardControlPlaneInitializedConditionType, scp.Generation, func() error {
+	TrackConditionType(&conditions, scpv1alpha2.StewardControlPlaneInitializedConditionType, scp.Generation, func() error {
 		err = r.updateStewardControlPlaneStatus(ctx, &scp, func() {
 			scp.Status.Initialized = true
+			scp.Status.Initialization = &scpv1alpha2.StewardControlPlaneInitializationStatus{ControlPlaneInitialized: ptr.To(true)}
 		})
 
 		return err
 	})
 
-	var result ctrl.Result
-
-	TrackConditionType(&conditions, scpv1alpha1.KubeadmResourcesCreatedReadyConditionType, scp.Generation, func() error {
+	TrackConditionType(&conditions, scpv1alpha2.KubeadmResourcesCreatedReadyConditionType, scp.Generation, func() error {
 		err = r.createRequiredResources(ctx, remoteClient, cluster, scp, tcp)
 
 		return err
@@ -311,15 +509,112 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 		if goerrors.Is(err, ErrEnqueueBack) {
 			log.Info(err.Error())
 
-			return ctrl.Result{RequeueAfter: time.Second}, nil
+			return r.enqueueBack(req, result), nil
 		}
 
 		log.Error(err, "unable to satisfy Secrets contract")
 
 		return ctrl.Result{}, err
 	}
+	// Replicating the additional key pairs defined by the Cluster API contract, when enabled:
+	// the ones not provided by Steward, such as the etcd Certificate Authority of a DataStore without TLS,
+	// are skipped and reported by the condition, rather than failing every reconciliation.
+	reader := r.client
+	if remoteClient != nil {
+		reader = remoteClient
+	}
+
+	if isClusterSecretsReplicationEnabled(scp) {
+		TrackConditionType(&conditions, scpv1alpha2.ClusterSecretsReplicatedConditionType, scp.Generation, func() error {
+			err = r.reconcileClusterSecrets(ctx, reader, cluster, scp, tcp)
+
+			return err
+		})
+	} else {
+		err = r.reconcileClusterSecrets(ctx, reader, cluster, scp, tcp)
+
+		meta.RemoveStatusCondition(&conditions, string(scpv1alpha2.ClusterSecretsReplicatedConditionType))
+	}
+
+	switch {
+	case goerrors.Is(err, ErrClusterSecretUnavailable):
+		log.Info(err.Error())
+	case goerrors.Is(err, ErrEnqueueBack):
+		log.Info(err.Error())
+
+		return r.enqueueBack(req, result), nil
+	case err != nil:
+		log.Error(err, "unable to replicate cluster secrets for the workload cluster")
+
+		return ctrl.Result{}, err
+	}
+	// Generating the non-admin kubeconfig for the end users, when enabled: the certificate requested to the
+	// workload cluster signer is collected by a later reconciliation, without holding the status update.
+	switch err = r.createOrUpdateUserKubeconfig(ctx, reader, cluster, scp, tcp); {
+	case goerrors.Is(err, ErrEnqueueBack):
+		log.Info(err.Error())
+
+		result = requeueAfter(result, userCertificateRetryAfter)
+	case err != nil:
+		log.Error(err, "unable to generate user kubeconfig secret for the workload cluster")
+
+		return ctrl.Result{}, err
+	}
+	// Completing the migration once the destination TenantControlPlane has been advertised.
+	var migrationRetryAfter time.Duration
+
+	migrationRetryAfter, err = r.reconcileMigrationCompletion(ctx, cluster, &scp, tcp)
+	setMigrationCondition(&conditions, scp, err)
+
+	if err != nil {
+		log.Error(err, "unable to complete the TenantControlPlane migration")
+
+		return ctrl.Result{}, err
+	}
 
-	TrackConditionType(&conditions, scpv1alpha1.StewardControlPlaneReadyConditionType, scp.Generation, func() error {
+	result = requeueAfter(result, migrationRetryAfter)
+	// Reporting the reduced capabilities of the workload cluster when the Certificate Authority private key is withheld.
+	if isCertificateAuthorityKeyWithheld(scp) {
+		meta.SetStatusCondition(&conditions, metav1.Condition{
+			Type:               string(scpv1alpha2.CertificateAuthorityKeyReplicatedConditionType),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: scp.Generation,
+			Reason:             string(scpv1alpha2.CertificateOnlyCertificateAuthorityReplication),
+			Message: "The " + cluster.Name + "-ca Secret contains the certificate with a placeholder private key: the worker nodes and the user kubeconfig " +
+				"certificates are issued by the workload cluster signers, the other flows requiring the private key are not supported",
+		})
+	} else {
+		meta.RemoveStatusCondition(&conditions, string(scpv1alpha2.CertificateAuthorityKeyReplicatedConditionType))
+	}
+
+	// Tracking the certificates expiration, and their rotation when requested with the annotation:
+	// the expiring certificates are reported with a Warning event, with no need to fail the reconciliation.
+	var certificatesRetryAfter time.Duration
+
+	var certificatesErr error
+
+	certificatesWereValid := !meta.IsStatusConditionFalse(conditions, string(scpv1alpha2.CertificatesNotExpiringConditionType))
+
+	TrackConditionType(&conditions, scpv1alpha2.CertificatesNotExpiringConditionType, scp.Generation, func() error {
+		certificatesRetryAfter, certificatesErr = r.reconcileCertificates(ctx, reader, &scp, tcp)
+
+		return certificatesErr
+	})
+
+	switch {
+	case goerrors.Is(certificatesErr, ErrCertificatesExpiring):
+		if certificatesWereValid {
+			r.recorder.Event(&scp, corev1.EventTypeWarning, "CertificatesExpiring", certificatesErr.Error())
+		}
+	case certificatesErr != nil:
+		log.Error(certificatesErr, "unable to track the certificates expiration")
+
+		return ctrl.Result{}, certificatesErr
+	}
+
+	result = requeueAfter(result, certificatesRetryAfter)
+
+	TrackConditionType(&conditions, scpv1alpha2.StewardControlPlaneReadyConditionType, scp.Generation, func() error {
 		err = r.updateStewardControlPlaneStatus(ctx, &scp, func() {
 			scp.Status.Ready = *tcp.Status.Kubernetes.Version.Status == stewardv1alpha1.VersionReady || *tcp.Status.Kubernetes.Version.Status == stewardv1alpha1.VersionUpgrading
 		})
@@ -338,20 +633,37 @@ func (r *StewardControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.
 		if goerrors.Is(err, ErrEnqueueBack) {
 			log.Info(err.Error())
 
-			return ctrl.Result{RequeueAfter: time.Second}, nil
+			return r.enqueueBack(req, result), nil
 		}
 
-		log.Error(err, "unable to report scpv1alpha1.StewardControlPlane readiness")
+		log.Error(err, "unable to report scpv1alpha2.StewardControlPlane readiness")
 
 		return ctrl.Result{}, err
 	}
 
 	log.Info("reconciliation completed", "duration", time.Since(now).String())
 
+	r.backoff.Forget(req.NamespacedName)
+
 	return result, nil
 }
 
-func (r *StewardControlPlaneReconciler) updateStewardControlPlaneStatus(ctx context.Context, scp *scpv1alpha1.StewardControlPlane, modifierFn func()) error {
+// enqueueBack returns the result for a reconciliation waiting for a change: the watches on the TenantControlPlane,
+// Cluster, and Secret objects are expected to trigger it, the per-object exponential backoff is a fallback for the missed ones.
+func (r *StewardControlPlaneReconciler) enqueueBack(req ctrl.Request, result ctrl.Result) ctrl.Result {
+	return requeueAfter(result, r.backoff.When(req.NamespacedName))
+}
+
+// requeueAfter returns the result with the earliest non-zero requeue delay.
+func requeueAfter(result ctrl.Result, delay time.Duration) ctrl.Result {
+	if delay > 0 && (result.RequeueAfter == 0 || delay < result.RequeueAfter) {
+		result.RequeueAfter = delay
+	}
+
+	return result
+}
+
+func (r *StewardControlPlaneReconciler) updateStewardControlPlaneStatus(ctx context.Context, scp *scpv1alpha2.StewardControlPlane, modifierFn func()) error {
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		if err := r.client.Get(ctx, types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace}, scp); err != nil {
 			return err //nolint:wrapcheck
@@ -369,16 +681,31 @@ func (r *StewardControlPlaneReconciler) updateStewardControlPlaneStatus(ctx cont
 }
 
 // SetupWithManager sets up the controller with the Manager.
-func (r *StewardControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, channel chan event.GenericEvent) error {
+func (r *StewardControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, trigger *externalclusterreference.Trigger) error {
 	r.client = mgr.GetClient()
+	r.recorder = mgr.GetEventRecorderFor("stewardcontrolplane-controller")
+	r.backoff = workqueue.NewTypedItemExponentialFailureRateLimiter[types.NamespacedName](r.RequeueMinDelay, r.RequeueMaxDelay)
+
 	ctrlBuilder := ctrl.NewControllerManagedBy(mgr).
-		For(&scpv1alpha1.StewardControlPlane{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+		For(&scpv1alpha2.StewardControlPlane{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
 			return len(object.GetOwnerReferences()) > 0
 		}))).
 		Owns(&corev1.Secret{}).
-		WatchesRawSource(source.Channel(channel, &handler.EnqueueRequestForObject{})).
-		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
-		WithEventFilter(predicates.ResourceNotPaused(mgr.GetScheme(), ctrl.LoggerFrom(ctx)))
+		Watches(&capiv1beta1.Cluster{}, handler.EnqueueRequestsFromMapFunc(clusterToStewardControlPlane), builder.WithPredicates(clusterChangedPredicate())).
+		WatchesRawSource(trigger).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+			// Failures are retried with the same backoff bounds, along with the controller-runtime default overall rate limiting.
+			RateLimiter: workqueue.NewTypedMaxOfRateLimiter(
+				workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](r.RequeueMinDelay, r.RequeueMaxDelay),
+				&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(10), 100)}, //nolint:mnd
+			),
+		})
+
+	if r.FeatureGates.Enabled(features.ExternalClusterReference) {
+		// Waiting for the Cluster API Cluster referenced as external cluster to be ready.
+		ctrlBuilder = ctrlBuilder.Watches(&capiv1beta1.Cluster{}, handler.EnqueueRequestsFromMapFunc(r.hostingClusterToStewardControlPlanes), builder.WithPredicates(hostingClusterReadyPredicate()))
+	}
 
 	cs, csErr := kubernetes.NewForConfig(mgr.GetConfig())
 	if csErr != nil {
@@ -386,9 +713,80 @@ func (r *StewardControlPlaneReconciler) SetupWithManager(ctx context.Context, mg
 	}
 
 	if _, rsErr := cs.Discovery().ServerResourcesForGroupVersion(stewardv1alpha1.GroupVersion.String()); rsErr == nil {
-		ctrlBuilder = ctrlBuilder.Owns(&stewardv1alpha1.TenantControlPlane{})
+		ctrlBuilder = ctrlBuilder.Owns(&stewardv1alpha1.TenantControlPlane{}).
+			// Propagating the changes of the Steward source Secrets, such as the certificates rotation.
+			Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.stewardSourceSecretToStewardControlPlane))
 	}
 
 	//nolint:wrapcheck
 	return ctrlBuilder.Complete(r)
 }
+
+// clusterToStewardControlPlane enqueues the StewardControlPlane referenced by the Cluster as its control plane.
+func clusterToStewardControlPlane(_ context.Context, object client.Object) []reconcile.Request {
+	cluster, ok := object.(*capiv1beta1.Cluster)
+	if !ok {
+		return nil
+	}
+
+	ref := cluster.Spec.ControlPlaneRef
+	if ref == nil || ref.Kind != "StewardControlPlane" || ref.GroupVersionKind().Group != scpv1alpha2.GroupVersion.Group {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: cluster.Namespace, Name: ref.Name}}}
+}
+
+// clusterChangedPredicate filters the Cluster updates relevant for the StewardControlPlane reconciliation,
+// such as the ready infrastructure, the Control Plane endpoint, or the paused state.
+func clusterChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, oldOk := e.ObjectOld.(*capiv1beta1.Cluster)
+			newCluster, newOk := e.ObjectNew.(*capiv1beta1.Cluster)
+
+			if !oldOk || !newOk {
+				return false
+			}
+
+			return oldCluster.Generation != newCluster.Generation ||
+				oldCluster.Status.InfrastructureReady != newCluster.Status.InfrastructureReady ||
+				annotations.HasPaused(oldCluster) != annotations.HasPaused(newCluster)
+		},
+	}
+}
+
+// hostingClusterToStewardControlPlanes enqueues the StewardControlPlanes referencing the Cluster as their external cluster.
+func (r *StewardControlPlaneReconciler) hostingClusterToStewardControlPlanes(ctx context.Context, object client.Object) []reconcile.Request {
+	var scpList scpv1alpha2.StewardControlPlaneList
+
+	if err := r.client.List(ctx, &scpList, client.MatchingFields{indexers.ExternalClusterReferenceClusterField: object.GetNamespace() + "/" + object.GetName()}); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "unable to use indexer", "cluster", object.GetName())
+
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(scpList.Items))
+
+	for _, scp := range scpList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: scp.Namespace, Name: scp.Name}})
+	}
+
+	return requests
+}
+
+// hostingClusterReadyPredicate filters the Cluster updates changing its readiness.
+func hostingClusterReadyPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, oldOk := e.ObjectOld.(*capiv1beta1.Cluster)
+			newCluster, newOk := e.ObjectNew.(*capiv1beta1.Cluster)
+
+			if !oldOk || !newOk {
+				return false
+			}
+
+			return isHostingClusterReady(oldCluster) != isHostingClusterReady(newCluster)
+		},
+	}
+}
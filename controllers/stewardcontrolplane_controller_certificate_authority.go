@@ -0,0 +1,161 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/client-go/util/retry"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+// isCertificateAuthorityProvided returns true when the Certificate Authority is provided with the <cluster>-ca Secret,
+// rather than being generated by Steward.
+func isCertificateAuthorityProvided(scp scpv1alpha2.StewardControlPlane) bool {
+	return scp.Spec.CertificateAuthority.Source == scpv1alpha2.ClusterCertificateAuthoritySource
+}
+
+// isCertificateAuthorityKeyWithheld returns true when the private key of the Certificate Authority generated by Steward
+// must not be replicated into the <cluster>-ca Secret.
+func isCertificateAuthorityKeyWithheld(scp scpv1alpha2.StewardControlPlane) bool {
+	return !isCertificateAuthorityProvided(scp) && scp.Spec.CertificateAuthority.Replication == scpv1alpha2.CertificateOnlyCertificateAuthorityReplication
+}
+
+// workerJoinPlaceholderKey returns the private key replicated in place of the withheld Certificate Authority one.
+// The kubeadm bootstrap provider requires a tls.key value for the worker nodes join, despite not using it: the nodes
+// authenticate with a bootstrap token, and their kubelet client certificate is issued by the
+// kubernetes.io/kube-apiserver-client-kubelet signer of the workload cluster, scoped to the node identities.
+// The placeholder key is unrelated to the Certificate Authority, thus the certificates signed with it are not trusted:
+// the previous one is kept, unless it's the Certificate Authority private key replicated before withholding it.
+func workerJoinPlaceholderKey(previous, caCrt []byte) ([]byte, error) {
+	if _, err := keyutil.ParsePrivateKeyPEM(previous); err == nil {
+		if _, err = tls.X509KeyPair(caCrt, previous); err != nil {
+			return previous, nil
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot generate the placeholder private key")
+	}
+
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot encode the placeholder private key")
+	}
+
+	return keyPEM, nil
+}
+
+// reconcileProvidedCertificateAuthority seeds the Certificate Authority provided with the <cluster>-ca Secret into Steward,
+// before the TenantControlPlane creation: Steward keeps a valid pre-existing Certificate Authority, rather than generating it.
+// The two Secrets are kept in sync at every reconciliation, such as when the provided Certificate Authority is renewed.
+func (r *StewardControlPlaneReconciler) reconcileProvidedCertificateAuthority(ctx context.Context, remoteClient client.Client, cluster capiv1beta1.Cluster, scp scpv1alpha2.StewardControlPlane) error {
+	capiCA := &corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: cluster.Name + "-ca", Namespace: cluster.Namespace}, capiCA); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("the %s-ca Secret providing the Certificate Authority is missing, %w", cluster.Name, ErrEnqueueBack)
+		}
+
+		return errors.Wrap(err, "cannot retrieve the provided Certificate Authority")
+	}
+
+	crt, key := capiCA.Data[corev1.TLSCertKey], capiCA.Data[corev1.TLSPrivateKeyKey]
+	if err := validateCertificateAuthority(crt, key); err != nil {
+		return errors.Wrapf(err, "invalid Certificate Authority provided with the %s Secret", capiCA.Name)
+	}
+
+	k8sClient, tcpKey := r.client, types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace}
+
+	if remoteClient != nil {
+		var err error
+
+		k8sClient = remoteClient
+
+		if tcpKey, err = remoteTenantControlPlaneKey(ctx, remoteClient, scp); err != nil {
+			return err
+		}
+	}
+
+	stewardCA := &corev1.Secret{}
+	stewardCA.Name = tcpKey.Name + "-ca"
+	stewardCA.Namespace = tcpKey.Namespace
+	// Sharing name and namespace, the provided Secret is used by Steward as is.
+	if remoteClient == nil && stewardCA.Name == capiCA.Name && stewardCA.Namespace == capiCA.Namespace {
+		return nil
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, scopeErr := controllerutil.CreateOrUpdate(ctx, k8sClient, stewardCA, func() error {
+			labels := stewardCA.Labels
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			labels["steward.butlerlabs.dev/component"] = "capi"
+			labels["steward.butlerlabs.dev/secret"] = "ca"
+			labels["steward.butlerlabs.dev/cluster"] = cluster.Name
+			labels["steward.butlerlabs.dev/tcp"] = tcpKey.Name
+			// Cached by the remote cluster manager, which is scoped to the Steward Secrets.
+			labels[stewardTenantControlPlaneNameLabel] = tcpKey.Name
+
+			stewardCA.SetLabels(labels)
+
+			if stewardCA.Data == nil {
+				stewardCA.Data = map[string][]byte{}
+			}
+			// Steward reads the kubeadm keys, along with the Cluster API ones.
+			stewardCA.Data["ca.crt"] = crt
+			stewardCA.Data["ca.key"] = key
+			stewardCA.Data[corev1.TLSCertKey] = crt
+			stewardCA.Data[corev1.TLSPrivateKeyKey] = key
+
+			return nil
+		})
+
+		return scopeErr //nolint:wrapcheck
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot seed the provided Certificate Authority")
+	}
+
+	return nil
+}
+
+// validateCertificateAuthority ensures the private key matches the certificate, which must be a valid Certificate Authority.
+func validateCertificateAuthority(crt, key []byte) error {
+	if _, err := tls.X509KeyPair(crt, key); err != nil {
+		return errors.Wrap(err, "mismatching certificate and private key")
+	}
+
+	caCrt, err := parseCertificate(crt)
+	if err != nil {
+		return err
+	}
+
+	if !caCrt.IsCA {
+		return errors.New("the certificate is not a Certificate Authority")
+	}
+
+	if now := time.Now(); now.Before(caCrt.NotBefore) || now.After(caCrt.NotAfter) {
+		return fmt.Errorf("the certificate is valid from %s to %s", caCrt.NotBefore.Format(time.RFC3339), caCrt.NotAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
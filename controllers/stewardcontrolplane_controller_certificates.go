@@ -0,0 +1,192 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+const (
+	// Labels and annotations used by Steward to manage the certificates lifecycle.
+	stewardTenantControlPlaneNameLabel  = "steward.butlerlabs.dev/name"
+	stewardCertificateLifecycleLabel    = "steward.butlerlabs.dev/certificate_lifecycle_controller"
+	stewardRotateCertificatesAnnotation = "certs.steward.butlerlabs.dev/rotate"
+	// certificatesRotationPollInterval is used to check the rotation progress performed by Steward.
+	certificatesRotationPollInterval = 10 * time.Second
+)
+
+var ErrCertificatesExpiring = errors.New("certificates approaching expiration")
+
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// reconcileCertificates tracks the expiration of the TenantControlPlane certificates, and their rotation once requested
+// with the annotation: the returned duration is the time to wait before checking them again.
+func (r *StewardControlPlaneReconciler) reconcileCertificates(ctx context.Context, reader client.Client, scp *scpv1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) (time.Duration, error) { //nolint:cyclop
+	stewardCA := &corev1.Secret{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: tcp.Status.Certificates.CA.SecretName, Namespace: tcp.Namespace}, stewardCA); err != nil {
+		return 0, errors.Wrap(err, "cannot retrieve source-of-truth as Certificate Authority")
+	}
+
+	caCrt, err := parseCertificate(stewardCA.Data["ca.crt"])
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot parse *stewardv1alpha1.TenantControlPlane CA")
+	}
+
+	stewardAdminKubeconfig := &corev1.Secret{}
+	if err = reader.Get(ctx, types.NamespacedName{Name: tcp.Status.KubeConfig.Admin.SecretName, Namespace: tcp.Namespace}, stewardAdminKubeconfig); err != nil {
+		return 0, errors.Wrap(err, "cannot retrieve source-of-truth for admin kubeconfig")
+	}
+
+	adminCrt, err := parseKubeconfigClientCertificate(stewardAdminKubeconfig.Data[kubeconfigSecretKey(*scp)])
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot parse *stewardv1alpha1.TenantControlPlane admin kubeconfig")
+	}
+
+	rotation, err := r.reconcileCertificatesRotation(ctx, reader, scp, tcp)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		scp.Status.Certificates = &scpv1alpha2.CertificatesStatus{
+			CertificateAuthorityNotAfter: &metav1.Time{Time: caCrt.NotAfter},
+			AdminClientNotAfter:          &metav1.Time{Time: adminCrt.NotAfter},
+			Rotation:                     rotation,
+		}
+	}); err != nil {
+		return 0, err
+	}
+
+	if rotation != nil && rotation.CompletedAt == nil {
+		return certificatesRotationPollInterval, nil
+	}
+	// Checking again once the first certificate will be approaching its expiration.
+	expiration := caCrt.NotAfter
+	if adminCrt.NotAfter.Before(expiration) {
+		expiration = adminCrt.NotAfter
+	}
+
+	if wait := time.Until(expiration) - r.CertificatesExpiryThreshold; wait > 0 {
+		return wait, nil
+	}
+
+	return 0, fmt.Errorf("the first certificate expires on %s, %w", expiration.Format(time.RFC3339), ErrCertificatesExpiring)
+}
+
+// reconcileCertificatesRotation requests Steward to rotate the certificates, tracking the progress:
+// each certificate is annotated by Steward with the rotation time once completed.
+func (r *StewardControlPlaneReconciler) reconcileCertificatesRotation(ctx context.Context, reader client.Client, scp *scpv1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) (*scpv1alpha2.CertificatesRotationStatus, error) {
+	var rotation *scpv1alpha2.CertificatesRotationStatus
+	if scp.Status.Certificates != nil {
+		rotation = scp.Status.Certificates.Rotation.DeepCopy()
+	}
+
+	inProgress := rotation != nil && rotation.CompletedAt == nil
+	// The rotation already requested to Steward is tracked until completion, even if the annotation has been removed meanwhile.
+	_, requested := scp.Annotations[scpv1alpha2.RotateCertificatesAnnotation]
+	if !requested && !inProgress {
+		return rotation, nil
+	}
+
+	var secrets corev1.SecretList
+	if err := reader.List(ctx, &secrets, client.InNamespace(tcp.Namespace), client.MatchingLabels{stewardTenantControlPlaneNameLabel: tcp.Name}, client.HasLabels{stewardCertificateLifecycleLabel}); err != nil {
+		return nil, errors.Wrap(err, "cannot list *stewardv1alpha1.TenantControlPlane certificates")
+	}
+	// A new rotation is started when no one is in progress.
+	if !inProgress {
+		ctrllog.FromContext(ctx).Info("requesting the certificates rotation", "certificates", len(secrets.Items))
+
+		for _, secret := range secrets.Items {
+			patch := client.MergeFrom(secret.DeepCopy())
+
+			if secret.Annotations == nil {
+				secret.Annotations = map[string]string{}
+			}
+
+			secret.Annotations[stewardRotateCertificatesAnnotation] = ""
+
+			if err := reader.Patch(ctx, &secret, patch); err != nil {
+				return nil, errors.Wrapf(err, "cannot request the rotation of the %s certificate", secret.Name)
+			}
+		}
+
+		return &scpv1alpha2.CertificatesRotationStatus{
+			RequestedAt: metav1.Now(),
+			Total:       int32(len(secrets.Items)), //nolint:gosec
+			Pending:     int32(len(secrets.Items)), //nolint:gosec
+		}, nil
+	}
+
+	rotation.Pending = 0
+
+	for _, secret := range secrets.Items {
+		if value, ok := secret.Annotations[stewardRotateCertificatesAnnotation]; ok && value == "" {
+			rotation.Pending++
+		}
+	}
+
+	if rotation.Pending > 0 {
+		return rotation, nil
+	}
+
+	rotation.CompletedAt = ptr.To(metav1.Now())
+
+	if requested {
+		patch := client.MergeFrom(scp.DeepCopy())
+		delete(scp.Annotations, scpv1alpha2.RotateCertificatesAnnotation)
+
+		if err := r.client.Patch(ctx, scp, patch); err != nil {
+			return nil, errors.Wrap(err, "cannot remove the certificates rotation annotation")
+		}
+	}
+
+	r.recorder.Eventf(scp, corev1.EventTypeNormal, "CertificatesRotated", "Rotated %d certificates of the TenantControlPlane", rotation.Total)
+
+	return rotation, nil
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	certs, err := cert.ParseCertsPEM(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse PEM certificate")
+	}
+
+	return certs[0], nil
+}
+
+// parseKubeconfigClientCertificate returns the client certificate of the kubeconfig current context.
+func parseKubeconfigClientCertificate(data []byte) (*x509.Certificate, error) {
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decode kubeconfig")
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, errors.New("missing current context")
+	}
+
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, errors.New("missing user of the current context")
+	}
+
+	return parseCertificate(authInfo.ClientCertificateData)
+}
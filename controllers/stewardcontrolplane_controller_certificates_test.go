@@ -0,0 +1,120 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	. "github.com/onsi/gomega" //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+func certificateSecret(name string, pending bool) *corev1.Secret {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "default",
+		Labels:    map[string]string{stewardTenantControlPlaneNameLabel: "cluster", stewardCertificateLifecycleLabel: "x509"},
+	}}
+
+	if pending {
+		secret.Annotations = map[string]string{stewardRotateCertificatesAnnotation: ""}
+	}
+
+	return secret
+}
+
+func TestReconcileCertificatesRotation(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested bool
+		rotation  *scpv1alpha2.CertificatesRotationStatus
+		pending   bool
+		// expected is the number of pending certificates, nil when no rotation is expected.
+		expected  *int32
+		completed bool
+	}{
+		{name: "not requested"},
+		{name: "requested", requested: true, expected: ptr.To[int32](2)},
+		{
+			name:      "in progress",
+			requested: true,
+			rotation:  &scpv1alpha2.CertificatesRotationStatus{RequestedAt: metav1.Now(), Total: 2, Pending: 2},
+			pending:   true,
+			expected:  ptr.To[int32](1),
+		},
+		{
+			name:      "completed",
+			requested: true,
+			rotation:  &scpv1alpha2.CertificatesRotationStatus{RequestedAt: metav1.Now(), Total: 2, Pending: 1},
+			expected:  ptr.To[int32](0),
+			completed: true,
+		},
+		{
+			name:     "in progress with the annotation removed",
+			rotation: &scpv1alpha2.CertificatesRotationStatus{RequestedAt: metav1.Now(), Total: 2, Pending: 2},
+			pending:  true,
+			expected: ptr.To[int32](1),
+		},
+		{
+			name:      "completed with the annotation removed",
+			rotation:  &scpv1alpha2.CertificatesRotationStatus{RequestedAt: metav1.Now(), Total: 2, Pending: 1},
+			expected:  ptr.To[int32](0),
+			completed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			scp := &scpv1alpha2.StewardControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+			if tt.requested {
+				scp.Annotations = map[string]string{scpv1alpha2.RotateCertificatesAnnotation: ""}
+			}
+
+			if tt.rotation != nil {
+				scp.Status.Certificates = &scpv1alpha2.CertificatesStatus{Rotation: tt.rotation}
+			}
+
+			tcp := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+
+			c := fake.NewClientBuilder().WithScheme(testScheme()).
+				WithObjects(scp, certificateSecret("cluster-api-server-certificate", tt.pending), certificateSecret("cluster-admin-kubeconfig", false)).
+				Build()
+			r := &StewardControlPlaneReconciler{client: c, recorder: record.NewFakeRecorder(10)}
+
+			rotation, err := r.reconcileCertificatesRotation(ctx, c, scp, tcp)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if tt.expected == nil {
+				g.Expect(rotation).To(BeNil())
+
+				return
+			}
+
+			g.Expect(rotation).NotTo(BeNil())
+			g.Expect(rotation.Pending).To(Equal(*tt.expected))
+			g.Expect(rotation.CompletedAt != nil).To(Equal(tt.completed))
+
+			var current scpv1alpha2.StewardControlPlane
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(scp), &current)).To(Succeed())
+			// The annotation is removed only once the rotation is completed.
+			if tt.requested && !tt.completed {
+				g.Expect(current.Annotations).To(HaveKey(scpv1alpha2.RotateCertificatesAnnotation))
+			} else {
+				g.Expect(current.Annotations).NotTo(HaveKey(scpv1alpha2.RotateCertificatesAnnotation))
+			}
+		})
+	}
+}
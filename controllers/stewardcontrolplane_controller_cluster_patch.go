@@ -17,7 +17,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 )
 
 const (
@@ -25,7 +25,7 @@ const (
 	defaultGatewayPort = 6443
 )
 
-func (r *StewardControlPlaneReconciler) controlPlaneEndpoint(controlPlane *v1alpha1.StewardControlPlane, statusEndpoint string) (string, int64, error) {
+func (r *StewardControlPlaneReconciler) controlPlaneEndpoint(controlPlane *v1alpha2.StewardControlPlane, statusEndpoint string) (string, int64, error) {
 	endpoint, strPort, err := net.SplitHostPort(statusEndpoint)
 	if err != nil {
 		return "", 0, errors.Wrap(err, "cannot split the Steward endpoint host port pair")
@@ -73,7 +73,7 @@ func parseHostnameWithDefault(hostname string, defaultPort int, label string) (s
 	return host, port, nil
 }
 
-func (r *StewardControlPlaneReconciler) patchControlPlaneEndpoint(ctx context.Context, controlPlane *v1alpha1.StewardControlPlane, hostPort string) error {
+func (r *StewardControlPlaneReconciler) patchControlPlaneEndpoint(ctx context.Context, controlPlane *v1alpha2.StewardControlPlane, hostPort string) error {
 	endpoint, port, err := r.controlPlaneEndpoint(controlPlane, hostPort)
 	if err != nil {
 		return errors.Wrap(err, "cannot retrieve ControlPlaneEndpoint")
@@ -94,7 +94,7 @@ func (r *StewardControlPlaneReconciler) patchControlPlaneEndpoint(ctx context.Co
 }
 
 //nolint:cyclop
-func (r *StewardControlPlaneReconciler) patchCluster(ctx context.Context, cluster capiv1beta1.Cluster, controlPlane *v1alpha1.StewardControlPlane, hostPort string) error {
+func (r *StewardControlPlaneReconciler) patchCluster(ctx context.Context, cluster capiv1beta1.Cluster, controlPlane *v1alpha2.StewardControlPlane, hostPort string) error {
 	if cluster.Spec.InfrastructureRef == nil {
 		return errors.New("capiv1beta1.Cluster has no InfrastructureRef")
 	}
@@ -0,0 +1,191 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+var ErrClusterSecretUnavailable = errors.New("not provided by Steward")
+
+// clusterSecret is a Cluster API Secret replicated from a Steward one,
+// keys maps the Steward Secret keys to the Cluster API Secret ones.
+// The Secret is skipped when the keys are missing and the unavailable reason is set, rather than failing the replication.
+type clusterSecret struct {
+	purpose     string
+	enabled     bool
+	sourceName  string
+	keys        map[string]string
+	unavailable string
+}
+
+// isClusterSecretsReplicationEnabled returns true when at least one of the Cluster API Secrets must be replicated.
+func isClusterSecretsReplicationEnabled(scp v1alpha2.StewardControlPlane) bool {
+	return scp.Spec.ClusterSecrets.ServiceAccount || scp.Spec.ClusterSecrets.FrontProxy || scp.Spec.ClusterSecrets.Etcd
+}
+
+func clusterSecrets(scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) []clusterSecret {
+	return []clusterSecret{
+		{
+			purpose:    "sa",
+			enabled:    scp.Spec.ClusterSecrets.ServiceAccount,
+			sourceName: tcp.Status.Certificates.SA.SecretName,
+			keys: map[string]string{
+				"sa.pub": corev1.TLSCertKey,
+				"sa.key": corev1.TLSPrivateKeyKey,
+			},
+		},
+		{
+			purpose:    "proxy",
+			enabled:    scp.Spec.ClusterSecrets.FrontProxy,
+			sourceName: tcp.Status.Certificates.FrontProxyCA.SecretName,
+			keys: map[string]string{
+				"front-proxy-ca.crt": corev1.TLSCertKey,
+				"front-proxy-ca.key": corev1.TLSPrivateKeyKey,
+			},
+		},
+		{
+			purpose:    "etcd",
+			enabled:    scp.Spec.ClusterSecrets.Etcd,
+			sourceName: tcp.Status.Storage.Certificate.SecretName,
+			keys: map[string]string{
+				"ca.crt": corev1.TLSCertKey,
+			},
+			// Steward provides the etcd Certificate Authority only for the DataStore with a TLS configuration.
+			unavailable: "the DataStore has no TLS configuration",
+		},
+	}
+}
+
+// reconcileClusterSecrets replicates the enabled Steward key pairs into the Secrets expected by the Cluster API contract,
+// deleting the ones previously replicated once disabled: the ones not provided by Steward are skipped,
+// and reported with the ErrClusterSecretUnavailable error once the others have been replicated.
+//
+// more info: https://cluster-api.sigs.k8s.io/developer/architecture/controllers/cluster.html#secrets
+func (r *StewardControlPlaneReconciler) reconcileClusterSecrets(ctx context.Context, reader client.Client, cluster capiv1beta1.Cluster, scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+	var unavailable []string
+
+	for _, cs := range clusterSecrets(scp, tcp) {
+		capiSecret := &corev1.Secret{}
+		capiSecret.Name = cluster.Name + "-" + cs.purpose
+		capiSecret.Namespace = cluster.Namespace
+
+		if !cs.enabled {
+			if err := r.deleteClusterSecret(ctx, scp, capiSecret); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if len(cs.sourceName) == 0 {
+			return fmt.Errorf("%s secret still unprocessed by Steward, %w", cs.purpose, ErrEnqueueBack)
+		}
+
+		if err := r.createOrUpdateClusterSecret(ctx, reader, cluster, scp, tcp, cs, capiSecret); err != nil {
+			if errors.Is(err, ErrClusterSecretUnavailable) {
+				unavailable = append(unavailable, cs.purpose+" since "+cs.unavailable)
+
+				continue
+			}
+
+			return err
+		}
+	}
+
+	if len(unavailable) > 0 {
+		return fmt.Errorf("skipping the %s secrets, %w", strings.Join(unavailable, ", "), ErrClusterSecretUnavailable)
+	}
+
+	return nil
+}
+
+func (r *StewardControlPlaneReconciler) createOrUpdateClusterSecret(ctx context.Context, reader client.Client, cluster capiv1beta1.Cluster, scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane, cs clusterSecret, capiSecret *corev1.Secret) error {
+	stewardSecret := &corev1.Secret{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: cs.sourceName, Namespace: tcp.Namespace}, stewardSecret); err != nil {
+		return errors.Wrapf(err, "cannot retrieve source-of-truth for %s secret", cs.purpose)
+	}
+
+	if cs.unavailable != "" {
+		for source := range cs.keys {
+			if _, found := stewardSecret.Data[source]; !found {
+				return ErrClusterSecretUnavailable
+			}
+		}
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, scopeErr := controllerutil.CreateOrUpdate(ctx, r.client, capiSecret, func() error {
+			data := make(map[string][]byte, len(cs.keys))
+
+			for source, destination := range cs.keys {
+				value, found := stewardSecret.Data[source]
+				if !found {
+					return errors.Errorf("missing %s value from *stewardv1alpha1.TenantControlPlane %s secret", source, cs.purpose)
+				}
+
+				data[destination] = value
+			}
+
+			labels := capiSecret.Labels
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			labels[capiv1beta1.ClusterNameLabel] = cluster.Name
+			labels["steward.butlerlabs.dev/component"] = "capi"
+			labels["steward.butlerlabs.dev/secret"] = cs.purpose
+			labels["steward.butlerlabs.dev/cluster"] = cluster.Name
+			labels["steward.butlerlabs.dev/tcp"] = tcp.Name
+
+			capiSecret.SetLabels(labels)
+
+			capiSecret.Data = data
+			// Only set Type on creation - Secret types are immutable
+			if capiSecret.CreationTimestamp.IsZero() {
+				capiSecret.Type = capiv1beta1.ClusterSecretType
+			}
+
+			return controllerutil.SetControllerReference(&scp, capiSecret, r.client.Scheme())
+		})
+
+		return scopeErr //nolint:wrapcheck
+	})
+	if err != nil {
+		return errors.Wrapf(err, "cannot create or update %s secret", cs.purpose)
+	}
+
+	return nil
+}
+
+// deleteClusterSecret removes a disabled Cluster API Secret, only if it has been replicated by the StewardControlPlane.
+func (r *StewardControlPlaneReconciler) deleteClusterSecret(ctx context.Context, scp v1alpha2.StewardControlPlane, secret *corev1.Secret) error {
+	if err := r.client.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, secret); err != nil {
+		return errors.Wrapf(client.IgnoreNotFound(err), "cannot retrieve %s secret", secret.Name)
+	}
+
+	if !metav1.IsControlledBy(secret, &scp) {
+		return nil
+	}
+
+	if err := r.client.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, "cannot delete %s secret", secret.Name)
+	}
+
+	return nil
+}
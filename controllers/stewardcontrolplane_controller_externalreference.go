@@ -5,13 +5,19 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 	ecr "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/features"
 )
@@ -27,30 +33,39 @@ var (
 	ErrExternalClusterReferenceSecretKeyEmpty             = errors.New("could not extract kubeconfig for external cluster reference, key is empty")
 	ErrExternalClusterReferenceNonInitializedStore        = errors.New("remote manager is not yet initialized")
 	ErrExternalClusterReferenceTenantControlPlaneNotFound = errors.New("TenantControlPlane custom resource not available in external cluster")
+	ErrExternalClusterReferenceClusterNotReady            = errors.New("the Cluster hosting the TenantControlPlane is not yet ready")
+	ErrExternalClusterReferenceAmbiguousAdoption          = errors.New("multiple TenantControlPlanes are labelled as belonging to the StewardControlPlane")
+	ErrExternalClusterReferenceNameConflict               = errors.New("the remote TenantControlPlane name is used by another StewardControlPlane")
 )
 
+func (r *StewardControlPlaneReconciler) extractRemoteClient(ctx context.Context, scp v1alpha2.StewardControlPlane) (client.Client, error) { //nolint:ireturn
+	return r.remoteClientFor(ctx, scp, scp.Spec.Deployment.ExternalClusterReference)
+}
+
+// remoteClientFor returns the client of the given external cluster, used by the StewardControlPlane:
+// besides the desired one, this is the source external cluster during a migration.
+//
 //nolint:cyclop
-func (r *StewardControlPlaneReconciler) extractRemoteClient(ctx context.Context, scp v1alpha1.StewardControlPlane) (client.Client, error) { //nolint:ireturn
+func (r *StewardControlPlaneReconciler) remoteClientFor(ctx context.Context, scp v1alpha2.StewardControlPlane, ref *v1alpha2.ExternalClusterReference) (client.Client, error) { //nolint:ireturn
 	if !r.FeatureGates.Enabled(features.ExternalClusterReference) {
 		return nil, ErrExternalClusterReferenceNotEnabled
 	}
 
+	namespace, name, key := ecr.KubeconfigSecretReference(scp.Namespace, ref)
+
 	if r.FeatureGates.Enabled(features.ExternalClusterReference) &&
 		!r.FeatureGates.Enabled(features.ExternalClusterReferenceCrossNamespace) &&
-		scp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretNamespace != "" &&
-		scp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretNamespace != scp.Namespace {
+		namespace != scp.Namespace {
 		return nil, ErrExternalClusterReferenceCrossNamespaceReference
 	}
 
-	namespace := scp.Namespace
-
-	if scp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretNamespace != "" {
-		namespace = scp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretNamespace
+	if err := r.ensureHostingClusterReady(ctx, scp.Namespace, ref); err != nil {
+		return nil, err
 	}
 
 	var secret corev1.Secret
 
-	if err := r.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: scp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretName}, &secret); err != nil {
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
 		return nil, errors.Wrapf(err, "could not get external cluster reference secret")
 	}
 
@@ -58,11 +73,11 @@ func (r *StewardControlPlaneReconciler) extractRemoteClient(ctx context.Context,
 		return nil, ErrExternalCLusterReferenceSecretEmptyError
 	}
 
-	if secret.Data[scp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretKey] == nil {
+	if secret.Data[key] == nil {
 		return nil, ErrExternalClusterReferenceSecretKeyEmpty
 	}
 
-	mgr, found := r.ExternalClusterReferenceStore.Get(ecr.GenerateKeyNameFromSteward(&scp), secret.ResourceVersion)
+	mgr, found := r.ExternalClusterReferenceStore.Get(ecr.GenerateKeyName(scp.Namespace, ref), secret.ResourceVersion)
 	if !found {
 		return nil, ErrExternalClusterReferenceNonInitializedStore
 	}
@@ -75,3 +90,105 @@ func (r *StewardControlPlaneReconciler) extractRemoteClient(ctx context.Context,
 
 	return mgr.GetClient(), nil
 }
+
+// remoteTenantControlPlaneKey returns the TenantControlPlane deployed to the external cluster:
+// an existing one labelled as belonging to the StewardControlPlane is adopted, regardless of its name,
+// such as when the StewardControlPlane has been recreated with a different UID upon a backup restore.
+func remoteTenantControlPlaneKey(ctx context.Context, remoteClient client.Client, scp v1alpha2.StewardControlPlane) (types.NamespacedName, error) {
+	name, namespace, err := ecr.GenerateRemoteTenantControlPlaneNames(scp)
+	if err != nil {
+		return types.NamespacedName{}, errors.Wrap(err, "cannot generate the remote TenantControlPlane name")
+	}
+
+	var tcpList stewardv1alpha1.TenantControlPlaneList
+
+	if err = remoteClient.List(ctx, &tcpList, client.InNamespace(namespace), client.MatchingLabels(ecr.StewardControlPlaneLabels(scp))); err != nil {
+		return types.NamespacedName{}, errors.Wrap(err, "cannot list the remote TenantControlPlanes")
+	}
+
+	switch len(tcpList.Items) {
+	case 0:
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		// Never binding the StewardControlPlane to the one of another StewardControlPlane, such as with a colliding template.
+		var existing stewardv1alpha1.TenantControlPlane
+		if err = remoteClient.Get(ctx, key, &existing); client.IgnoreNotFound(err) != nil {
+			return types.NamespacedName{}, errors.Wrap(err, "cannot retrieve the remote TenantControlPlane")
+		}
+
+		if owner, ok := ecr.ParseStewardControlPlaneFromTenantControlPlane(existing); err == nil && ok && owner != client.ObjectKeyFromObject(&scp) {
+			return types.NamespacedName{}, fmt.Errorf("%w: %s belongs to %s", ErrExternalClusterReferenceNameConflict, key, owner)
+		}
+
+		return key, nil
+	case 1:
+		return types.NamespacedName{Name: tcpList.Items[0].Name, Namespace: namespace}, nil
+	default:
+		return types.NamespacedName{}, fmt.Errorf("%w in the %s Namespace", ErrExternalClusterReferenceAmbiguousAdoption, namespace)
+	}
+}
+
+// ensureHostingClusterReady ensures the Cluster API Cluster referenced as the external cluster is ready,
+// before using its kubeconfig Secret: a no-op when the kubeconfig Secret is directly referenced.
+func (r *StewardControlPlaneReconciler) ensureHostingClusterReady(ctx context.Context, namespace string, ref *v1alpha2.ExternalClusterReference) error {
+	namespace, name := ecr.HostingClusterReference(namespace, ref)
+	if name == "" {
+		return nil
+	}
+
+	var cluster capiv1beta1.Cluster
+
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &cluster); err != nil {
+		return errors.Wrapf(err, "could not get the Cluster hosting the TenantControlPlane")
+	}
+
+	if !isHostingClusterReady(&cluster) {
+		return ErrExternalClusterReferenceClusterNotReady
+	}
+
+	return nil
+}
+
+func isHostingClusterReady(cluster *capiv1beta1.Cluster) bool {
+	for _, condition := range cluster.Status.Conditions {
+		if condition.Type == capiv1beta1.ReadyCondition {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// setExternalClusterReferenceHealthCondition reports the health of the manager of the external cluster,
+// such as the failures of the remote API Server, and the upcoming restart.
+func setExternalClusterReferenceHealthCondition(conditions *[]metav1.Condition, scp v1alpha2.StewardControlPlane, store ecr.Store) {
+	condition := metav1.Condition{
+		Type:               string(v1alpha2.ExternalClusterReferenceHealthyConditionType),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: scp.Generation,
+	}
+
+	var health ecr.Health
+
+	var found bool
+
+	if store != nil {
+		health, found = store.Health(ecr.GenerateKeyNameFromSteward(&scp))
+	}
+
+	switch {
+	case !found:
+		condition.Reason, condition.Message = "NotStarted", "the manager of the external cluster is not yet started"
+	case health.Failed():
+		condition.Reason = "Failed"
+		condition.Message = fmt.Sprintf("the manager of the external cluster failed %d consecutive times, restarting at %s: %s",
+			health.Failures, health.NextRestart.UTC().Format(time.RFC3339), health.LastError.Error())
+	case !health.Started:
+		condition.Reason, condition.Message = "NotStarted", "the manager of the external cluster is not yet started"
+	case !health.CacheSynced:
+		condition.Reason, condition.Message = "CacheSyncing", "the manager of the external cluster is syncing its cache"
+	default:
+		condition.Status, condition.Reason = metav1.ConditionTrue, "Healthy"
+	}
+
+	meta.SetStatusCondition(conditions, condition)
+}
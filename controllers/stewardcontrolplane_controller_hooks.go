@@ -0,0 +1,151 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/runtimehooks"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/upgrade"
+)
+
+// isRuntimeSDKEnabled returns true when the lifecycle hooks must be called by the StewardControlPlane reconciler:
+// for ClusterClass based Cluster objects, the hooks are already called by the Cluster API topology controller.
+func (r *StewardControlPlaneReconciler) isRuntimeSDKEnabled(cluster capiv1beta1.Cluster) bool {
+	return r.RuntimeClient != nil && cluster.Spec.Topology == nil
+}
+
+// reconcileBeforeClusterUpgradeHook calls the BeforeClusterUpgrade hook when the desired Kubernetes version differs
+// from the one applied to the TenantControlPlane: a non-zero duration means the upgrade is blocked by an extension.
+func (r *StewardControlPlaneReconciler) reconcileBeforeClusterUpgradeHook(ctx context.Context, remoteClient client.Client, cluster capiv1beta1.Cluster, scp *scpv1alpha2.StewardControlPlane) (time.Duration, error) {
+	k8sClient, key := r.client, types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace}
+
+	if remoteClient != nil {
+		var err error
+
+		k8sClient = remoteClient
+
+		if key, err = remoteTenantControlPlaneKey(ctx, remoteClient, *scp); err != nil {
+			return 0, err
+		}
+	}
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := k8sClient.Get(ctx, key, &tcp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+
+		return 0, errors.Wrap(err, "cannot retrieve the TenantControlPlane")
+	}
+
+	current, desired := tcp.Spec.Kubernetes.Version, kubernetesVersion(*scp)
+	// Only upgrades allowed by the version skew policy are subject to the hook.
+	if current == "" || current == desired || upgrade.ValidateVersionChange(tcp.Status.Kubernetes.Version.Version, current, desired) != nil {
+		return 0, nil
+	}
+
+	request := &runtimehooksv1.BeforeClusterUpgradeRequest{
+		Cluster:               hookCluster(cluster),
+		FromKubernetesVersion: current,
+		ToKubernetesVersion:   desired,
+	}
+	response := &runtimehooksv1.BeforeClusterUpgradeResponse{}
+
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.BeforeClusterUpgrade, scp, request, response); err != nil {
+		return 0, errors.Wrap(err, "cannot call the BeforeClusterUpgrade hook")
+	}
+
+	if response.RetryAfterSeconds > 0 {
+		ctrllog.FromContext(ctx).Info("upgrade blocked by the BeforeClusterUpgrade hook", "from", current, "to", desired, "retryAfterSeconds", response.RetryAfterSeconds)
+
+		return time.Duration(response.RetryAfterSeconds) * time.Second, nil
+	}
+
+	if err := runtimehooks.MarkAsPending(ctx, r.client, scp, runtimehooksv1.AfterControlPlaneUpgrade); err != nil {
+		return 0, errors.Wrap(err, "cannot mark the AfterControlPlaneUpgrade hook as pending")
+	}
+
+	return 0, nil
+}
+
+// reconcileAfterControlPlaneInitializedHook calls the non-blocking AfterControlPlaneInitialized hook, if pending.
+func (r *StewardControlPlaneReconciler) reconcileAfterControlPlaneInitializedHook(ctx context.Context, cluster capiv1beta1.Cluster, scp *scpv1alpha2.StewardControlPlane) error {
+	if !runtimehooks.IsPending(runtimehooksv1.AfterControlPlaneInitialized, scp) {
+		return nil
+	}
+
+	request := &runtimehooksv1.AfterControlPlaneInitializedRequest{Cluster: hookCluster(cluster)}
+	response := &runtimehooksv1.AfterControlPlaneInitializedResponse{}
+
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.AfterControlPlaneInitialized, scp, request, response); err != nil {
+		return errors.Wrap(err, "cannot call the AfterControlPlaneInitialized hook")
+	}
+
+	return runtimehooks.MarkAsDone(ctx, r.client, scp, runtimehooksv1.AfterControlPlaneInitialized) //nolint:wrapcheck
+}
+
+// reconcileAfterControlPlaneUpgradeHook calls the AfterControlPlaneUpgrade hook, if pending, once the TenantControlPlane
+// has been upgraded to the desired version: a non-zero duration means the completion is blocked by an extension.
+func (r *StewardControlPlaneReconciler) reconcileAfterControlPlaneUpgradeHook(ctx context.Context, cluster capiv1beta1.Cluster, scp *scpv1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) (time.Duration, error) {
+	if !runtimehooks.IsPending(runtimehooksv1.AfterControlPlaneUpgrade, scp) {
+		return 0, nil
+	}
+
+	if status := tcp.Status.Kubernetes.Version.Status; status == nil || *status != stewardv1alpha1.VersionReady || tcp.Status.Kubernetes.Version.Version != tcp.Spec.Kubernetes.Version {
+		return 0, nil
+	}
+
+	request := &runtimehooksv1.AfterControlPlaneUpgradeRequest{
+		Cluster:           hookCluster(cluster),
+		KubernetesVersion: tcp.Spec.Kubernetes.Version,
+	}
+	response := &runtimehooksv1.AfterControlPlaneUpgradeResponse{}
+
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.AfterControlPlaneUpgrade, scp, request, response); err != nil {
+		return 0, errors.Wrap(err, "cannot call the AfterControlPlaneUpgrade hook")
+	}
+
+	if response.RetryAfterSeconds > 0 {
+		return time.Duration(response.RetryAfterSeconds) * time.Second, nil
+	}
+
+	if err := runtimehooks.MarkAsDone(ctx, r.client, scp, runtimehooksv1.AfterControlPlaneUpgrade); err != nil {
+		return 0, errors.Wrap(err, "cannot mark the AfterControlPlaneUpgrade hook as done")
+	}
+
+	return 0, nil
+}
+
+// kubernetesVersion tolerates version strings without a "v" prefix: prepend it if it's not there.
+func kubernetesVersion(scp scpv1alpha2.StewardControlPlane) string {
+	if !strings.HasPrefix(scp.Spec.Version, "v") {
+		return "v" + scp.Spec.Version
+	}
+
+	return scp.Spec.Version
+}
+
+// hookCluster removes the fields not relevant for the extensions from the Cluster sent with the hook requests.
+func hookCluster(cluster capiv1beta1.Cluster) capiv1beta1.Cluster {
+	cluster = *cluster.DeepCopy()
+	cluster.ManagedFields = nil
+
+	delete(cluster.Annotations, corev1.LastAppliedConfigAnnotation)
+
+	return cluster
+}
@@ -0,0 +1,577 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	stewardfinalizers "github.com/butlerdotdev/steward/controllers/finalizers"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	ecr "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
+)
+
+// migrationRetryAfter is the delay to check the deletion of the source TenantControlPlane.
+const migrationRetryAfter = 5 * time.Second
+
+var (
+	ErrMigrationDestinationChanged = errors.New("the hosting cluster changed during the migration, restore the destination one to complete it, or the source one to roll it back")
+	ErrMigrationDataStoreNotFound  = errors.New("the DataStore of the source TenantControlPlane is missing in the destination hosting cluster")
+	ErrMigrationDataStoreMismatch  = errors.New("the DataStore of the destination hosting cluster differs from the source one")
+	ErrMigrationEndpointChanged    = errors.New("the destination TenantControlPlane endpoint differs from the Cluster one, which the workload cluster nodes are configured with")
+	// ErrMigrationRolledBack reports the migration has been rolled back, the source TenantControlPlane being preserved.
+	ErrMigrationRolledBack = errors.New("the migration has been rolled back to the source hosting cluster")
+	// ErrMigrationUnknownFinalizer reports a Steward finalizer the migration doesn't know whether it tears the DataStore down.
+	ErrMigrationUnknownFinalizer = errors.New("the migrated TenantControlPlane has an unknown Steward finalizer, refusing to delete it")
+)
+
+// migrationKnownStewardFinalizers are the Steward finalizers of a TenantControlPlane, and of its DataStore configuration Secret,
+// known by the migration: Steward has no supported way to skip the DataStore teardown, so the deletion relies on these names,
+// pinned by the tests against the vendored Steward release.
+var migrationKnownStewardFinalizers = sets.New(stewardfinalizers.DatastoreFinalizer, stewardfinalizers.DatastoreSecretFinalizer, stewardfinalizers.SootFinalizer)
+
+// migrationRolledBackReason is the reason of the migration condition once rolled back.
+const migrationRolledBackReason = "RolledBack"
+
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=datastores,verbs=get;list;watch
+
+// desiredHosting returns the hosting of the TenantControlPlane, as defined by the StewardControlPlane.
+func desiredHosting(ctx context.Context, remoteClient client.Client, scp v1alpha2.StewardControlPlane) (v1alpha2.HostingStatus, error) {
+	hosting := v1alpha2.HostingStatus{
+		TenantControlPlaneName:      scp.Name,
+		TenantControlPlaneNamespace: scp.Namespace,
+	}
+
+	if remoteClient == nil {
+		return hosting, nil
+	}
+
+	key, err := remoteTenantControlPlaneKey(ctx, remoteClient, scp)
+	if err != nil {
+		return v1alpha2.HostingStatus{}, err
+	}
+
+	hosting.ExternalClusterReference = scp.Spec.Deployment.ExternalClusterReference.DeepCopy()
+	hosting.TenantControlPlaneName, hosting.TenantControlPlaneNamespace = key.Name, key.Namespace
+
+	return hosting, nil
+}
+
+// isSameHosting returns true when the two hostings refer to the same cluster and deployment namespace.
+func isSameHosting(namespace string, a, b *v1alpha2.ExternalClusterReference) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return ecr.GenerateKeyName(namespace, a) == ecr.GenerateKeyName(namespace, b) && a.DeploymentNamespace == b.DeploymentNamespace
+}
+
+// hostingClient returns the client of the cluster hosting the given TenantControlPlane.
+func (r *StewardControlPlaneReconciler) hostingClient(ctx context.Context, scp v1alpha2.StewardControlPlane, hosting v1alpha2.HostingStatus) (client.Client, error) { //nolint:ireturn
+	if hosting.ExternalClusterReference == nil {
+		return r.client, nil
+	}
+
+	return r.remoteClientFor(ctx, scp, hosting.ExternalClusterReference)
+}
+
+// reconcileMigrationStart starts the migration of the TenantControlPlane once the hosting cluster changed,
+// seeding the certificates and the DataStore credentials of the source TenantControlPlane into the destination one:
+// Steward keeps the pre-existing valid Secrets, rather than generating them.
+func (r *StewardControlPlaneReconciler) reconcileMigrationStart(ctx context.Context, remoteClient client.Client, scp *v1alpha2.StewardControlPlane) error {
+	destination, err := desiredHosting(ctx, remoteClient, *scp)
+	if err != nil {
+		return err
+	}
+
+	migration := scp.Status.Migration
+
+	if migration == nil && scp.Status.Hosting == nil {
+		if err = r.reconcileUnreportedHosting(ctx, scp); err != nil {
+			return err
+		}
+	}
+
+	if migration == nil {
+		source := scp.Status.Hosting
+		if source == nil || isSameHosting(scp.Namespace, source.ExternalClusterReference, destination.ExternalClusterReference) {
+			return nil
+		}
+
+		sourceClient, sourceErr := r.hostingClient(ctx, *scp, *source)
+		if sourceErr != nil {
+			return errors.Wrap(sourceErr, "cannot retrieve the source hosting cluster client")
+		}
+
+		var tcp stewardv1alpha1.TenantControlPlane
+		if err = sourceClient.Get(ctx, types.NamespacedName{Name: source.TenantControlPlaneName, Namespace: source.TenantControlPlaneNamespace}, &tcp); err != nil {
+			// Nothing to migrate, the TenantControlPlane is created in the destination hosting cluster.
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+
+			return errors.Wrap(err, "cannot retrieve the source TenantControlPlane")
+		}
+
+		ctrllog.FromContext(ctx).Info("starting the TenantControlPlane migration", "source", source.TenantControlPlaneNamespace+"/"+source.TenantControlPlaneName)
+
+		if err = r.updateStewardControlPlaneStatus(ctx, scp, func() {
+			scp.Status.Migration = &v1alpha2.MigrationStatus{
+				Source:            *source,
+				Destination:       destination,
+				Phase:             v1alpha2.SeedingSecretsMigrationPhase,
+				StartTime:         metav1.Now(),
+				DataStoreName:     tcp.Status.Storage.DataStoreName,
+				DataStoreSchema:   tcp.Status.Storage.Setup.Schema,
+				DataStoreUsername: tcp.Status.Storage.Setup.User,
+			}
+		}); err != nil {
+			return err
+		}
+		// Seeding the Secrets in the same pass, before the destination TenantControlPlane is created:
+		// Steward would generate new Certificate Authorities and Service Account key pair otherwise.
+		migration = scp.Status.Migration
+	}
+
+	if !isSameHosting(scp.Namespace, migration.Destination.ExternalClusterReference, destination.ExternalClusterReference) {
+		// Restoring the source hosting cluster rolls the migration back, as long as the source TenantControlPlane is still there.
+		if migration.Phase != v1alpha2.DeletingSourceMigrationPhase && isSameHosting(scp.Namespace, migration.Source.ExternalClusterReference, destination.ExternalClusterReference) {
+			return r.rollbackMigration(ctx, scp, *migration)
+		}
+
+		return ErrMigrationDestinationChanged
+	}
+
+	if migration.Phase != v1alpha2.SeedingSecretsMigrationPhase {
+		return nil
+	}
+	// The destination TenantControlPlane would never be ready without the same DataStore.
+	if err = r.ensureMigrationDataStore(ctx, remoteClient, *scp, *migration); err != nil {
+		return err
+	}
+
+	if err = r.seedMigrationSecrets(ctx, remoteClient, *scp, *migration); err != nil {
+		return err
+	}
+
+	return r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		if scp.Status.Migration != nil {
+			scp.Status.Migration.Phase = v1alpha2.ProvisioningDestinationMigrationPhase
+		}
+	})
+}
+
+// ensureMigrationDataStore ensures the DataStore used by the source TenantControlPlane is available in the destination hosting cluster:
+// the DataStores are cluster-scoped, the one with the same name must target the same backend.
+func (r *StewardControlPlaneReconciler) ensureMigrationDataStore(ctx context.Context, remoteClient client.Client, scp v1alpha2.StewardControlPlane, migration v1alpha2.MigrationStatus) error {
+	if migration.DataStoreName == "" {
+		return nil
+	}
+
+	sourceClient, err := r.hostingClient(ctx, scp, migration.Source)
+	if err != nil {
+		return errors.Wrap(err, "cannot retrieve the source hosting cluster client")
+	}
+
+	destinationClient := r.client
+	if remoteClient != nil {
+		destinationClient = remoteClient
+	}
+
+	var source stewardv1alpha1.DataStore
+	if err = sourceClient.Get(ctx, types.NamespacedName{Name: migration.DataStoreName}, &source); err != nil {
+		return errors.Wrapf(err, "cannot retrieve the %s source DataStore", migration.DataStoreName)
+	}
+
+	var destination stewardv1alpha1.DataStore
+	if err = destinationClient.Get(ctx, types.NamespacedName{Name: migration.DataStoreName}, &destination); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", ErrMigrationDataStoreNotFound, migration.DataStoreName)
+		}
+
+		return errors.Wrapf(err, "cannot retrieve the %s destination DataStore", migration.DataStoreName)
+	}
+
+	if source.Spec.Driver != destination.Spec.Driver || !sets.New(source.Spec.Endpoints...).Equal(sets.New(destination.Spec.Endpoints...)) {
+		return fmt.Errorf("%w: %s", ErrMigrationDataStoreMismatch, migration.DataStoreName)
+	}
+
+	return nil
+}
+
+// rollbackMigration deletes the destination TenantControlPlane once the source hosting cluster has been restored,
+// preserving the DataStore content shared with the source one, which keeps serving the workload cluster.
+func (r *StewardControlPlaneReconciler) rollbackMigration(ctx context.Context, scp *v1alpha2.StewardControlPlane, migration v1alpha2.MigrationStatus) error {
+	sourceClient, err := r.hostingClient(ctx, *scp, migration.Source)
+	if err != nil {
+		return errors.Wrap(err, "cannot retrieve the source hosting cluster client")
+	}
+
+	var source stewardv1alpha1.TenantControlPlane
+	if err = sourceClient.Get(ctx, types.NamespacedName{Name: migration.Source.TenantControlPlaneName, Namespace: migration.Source.TenantControlPlaneNamespace}, &source); err != nil {
+		return errors.Wrap(err, "cannot retrieve the source TenantControlPlane")
+	}
+
+	deleted, err := r.deleteMigrationTenantControlPlane(ctx, *scp, migration.Destination, source.UID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("rolling back the migration, the destination TenantControlPlane is still being deleted, %w", ErrEnqueueBack)
+	}
+
+	message := fmt.Sprintf("the migration to the %s TenantControlPlane has been rolled back", hostingName(migration.Destination))
+
+	ctrllog.FromContext(ctx).Info(message)
+	r.recorder.Event(scp, corev1.EventTypeNormal, "MigrationRolledBack", message)
+
+	if err = r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		scp.Status.Migration = nil
+	}); err != nil {
+		return err
+	}
+	// Rolled back to the management cluster, the external cluster finalizer is no longer required.
+	if migration.Source.ExternalClusterReference == nil {
+		if err = r.removeExternalClusterReferenceFinalizer(ctx, scp); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w, the %s TenantControlPlane has been deleted", ErrMigrationRolledBack, hostingName(migration.Destination))
+}
+
+// seedMigrationSecrets copies the Steward Secrets which must be preserved by the migration, such as the Certificate Authorities,
+// the Service Account key pair, and the DataStore credentials, renamed according to the destination TenantControlPlane.
+func (r *StewardControlPlaneReconciler) seedMigrationSecrets(ctx context.Context, remoteClient client.Client, scp v1alpha2.StewardControlPlane, migration v1alpha2.MigrationStatus) error {
+	sourceClient, err := r.hostingClient(ctx, scp, migration.Source)
+	if err != nil {
+		return errors.Wrap(err, "cannot retrieve the source hosting cluster client")
+	}
+
+	destinationClient := r.client
+	if remoteClient != nil {
+		destinationClient = remoteClient
+	}
+
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err = sourceClient.Get(ctx, types.NamespacedName{Name: migration.Source.TenantControlPlaneName, Namespace: migration.Source.TenantControlPlaneNamespace}, &tcp); err != nil {
+		return errors.Wrap(err, "cannot retrieve the source TenantControlPlane")
+	}
+
+	secretNames := []string{
+		tcp.Status.Certificates.SA.SecretName,
+		tcp.Status.Certificates.FrontProxyCA.SecretName,
+		tcp.Status.Storage.Config.SecretName,
+	}
+	// The provided Certificate Authority is already seeded.
+	if !isCertificateAuthorityProvided(scp) {
+		secretNames = append(secretNames, tcp.Status.Certificates.CA.SecretName)
+	}
+
+	for _, secretName := range secretNames {
+		if secretName == "" {
+			continue
+		}
+
+		source := &corev1.Secret{}
+		if err = sourceClient.Get(ctx, types.NamespacedName{Name: secretName, Namespace: tcp.Namespace}, source); err != nil {
+			return errors.Wrapf(err, "cannot retrieve the %s source Secret", secretName)
+		}
+
+		destination := &corev1.Secret{}
+		destination.Name = migration.Destination.TenantControlPlaneName + strings.TrimPrefix(secretName, tcp.Name)
+		destination.Namespace = migration.Destination.TenantControlPlaneNamespace
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			_, scopeErr := controllerutil.CreateOrUpdate(ctx, destinationClient, destination, func() error {
+				// Steward keeps the existing values once the destination TenantControlPlane owns the Secret.
+				if !destination.CreationTimestamp.IsZero() {
+					return nil
+				}
+
+				destination.Labels = source.Labels
+				destination.Annotations = source.Annotations
+				destination.Type = source.Type
+				destination.Data = source.Data
+
+				return nil
+			})
+
+			return scopeErr //nolint:wrapcheck
+		})
+		if err != nil {
+			return errors.Wrapf(err, "cannot seed the %s Secret", destination.Name)
+		}
+	}
+
+	return nil
+}
+
+// reconcileUnreportedHosting infers the cluster hosting the TenantControlPlane when not yet reported,
+// such as for the StewardControlPlane reconciled by a previous version, once the ExternalClusterReference is added or removed.
+func (r *StewardControlPlaneReconciler) reconcileUnreportedHosting(ctx context.Context, scp *v1alpha2.StewardControlPlane) error {
+	if scp.Spec.Deployment.ExternalClusterReference == nil {
+		// The removed ExternalClusterReference is unknown, the remote TenantControlPlane can't be cleaned up.
+		if !controllerutil.ContainsFinalizer(scp, ExternalClusterReferenceFinalizer) {
+			return nil
+		}
+
+		message := "the ExternalClusterReference has been removed before the hosting cluster was reported, the remote TenantControlPlane could be orphaned"
+
+		ctrllog.FromContext(ctx).Info(message)
+		r.recorder.Event(scp, corev1.EventTypeWarning, "RemoteResourcesOrphaned", message)
+
+		return r.removeExternalClusterReferenceFinalizer(ctx, scp)
+	}
+	// The TenantControlPlane deployed to the management cluster before the ExternalClusterReference has been added.
+	var tcp stewardv1alpha1.TenantControlPlane
+	if err := r.client.Get(ctx, types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace}, &tcp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "cannot retrieve the management cluster TenantControlPlane")
+	}
+
+	if !metav1.IsControlledBy(&tcp, scp) {
+		return nil
+	}
+
+	return r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		scp.Status.Hosting = &v1alpha2.HostingStatus{
+			TenantControlPlaneName:      tcp.Name,
+			TenantControlPlaneNamespace: tcp.Namespace,
+		}
+	})
+}
+
+// setHosting reports the cluster hosting the TenantControlPlane, recording the previous one upon a placement change.
+func setHosting(scp *v1alpha2.StewardControlPlane, hosting v1alpha2.HostingStatus) {
+	if current := scp.Status.Hosting; current != nil && !isSameHosting(scp.Namespace, current.ExternalClusterReference, hosting.ExternalClusterReference) {
+		scp.Status.PreviousHosting = current.DeepCopy()
+	}
+
+	scp.Status.Hosting = &hosting
+}
+
+// recordHosting reports the cluster hosting the TenantControlPlane, used as source of an upcoming migration.
+func (r *StewardControlPlaneReconciler) recordHosting(ctx context.Context, scp *v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+	hosting := &v1alpha2.HostingStatus{
+		ExternalClusterReference:    scp.Spec.Deployment.ExternalClusterReference.DeepCopy(),
+		TenantControlPlaneName:      tcp.Name,
+		TenantControlPlaneNamespace: tcp.Namespace,
+	}
+
+	if equality.Semantic.DeepEqual(scp.Status.Hosting, hosting) {
+		return nil
+	}
+
+	return r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		setHosting(scp, *hosting)
+	})
+}
+
+// reconcileMigrationProvisioning waits for the destination TenantControlPlane to be ready,
+// before advertising its kubeconfig: its endpoint must be the Cluster one, keeping the workload cluster nodes connected.
+func (r *StewardControlPlaneReconciler) reconcileMigrationProvisioning(ctx context.Context, cluster capiv1beta1.Cluster, scp *v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+	migration := scp.Status.Migration
+	if migration == nil || migration.Phase != v1alpha2.ProvisioningDestinationMigrationPhase {
+		return nil
+	}
+
+	if status := tcp.Status.Kubernetes.Version.Status; status == nil || *status != stewardv1alpha1.VersionReady {
+		return fmt.Errorf("the destination TenantControlPlane is not yet ready, %w", ErrEnqueueBack)
+	}
+
+	if len(tcp.Status.ControlPlaneEndpoint) == 0 {
+		return fmt.Errorf("the destination TenantControlPlane endpoint is not yet available, %w", ErrEnqueueBack)
+	}
+
+	host, port, err := r.controlPlaneEndpoint(scp, tcp.Status.ControlPlaneEndpoint)
+	if err != nil {
+		return errors.Wrap(err, "cannot retrieve the destination TenantControlPlane endpoint")
+	}
+
+	if err = ensureMigrationEndpoint(cluster, capiv1beta1.APIEndpoint{Host: host, Port: int32(port)}); err != nil { //nolint:gosec
+		return err
+	}
+
+	return r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		if scp.Status.Migration != nil {
+			scp.Status.Migration.Phase = v1alpha2.SwitchingEndpointMigrationPhase
+		}
+	})
+}
+
+// reconcileMigrationCompletion deletes the source TenantControlPlane once the destination one has been advertised,
+// preserving the DataStore content shared by the two: a non-zero duration means the switch or the deletion is still in progress.
+func (r *StewardControlPlaneReconciler) reconcileMigrationCompletion(ctx context.Context, cluster capiv1beta1.Cluster, scp *v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) (time.Duration, error) {
+	migration := scp.Status.Migration
+	if migration == nil || (migration.Phase != v1alpha2.SwitchingEndpointMigrationPhase && migration.Phase != v1alpha2.DeletingSourceMigrationPhase) {
+		return 0, nil
+	}
+
+	if migration.Phase == v1alpha2.SwitchingEndpointMigrationPhase {
+		// The source TenantControlPlane is kept serving the nodes, unless the Cluster still reports the same endpoint.
+		if err := ensureMigrationEndpoint(cluster, scp.Spec.ControlPlaneEndpoint); err != nil {
+			return 0, err
+		}
+
+		if err := r.updateStewardControlPlaneStatus(ctx, scp, func() {
+			if scp.Status.Migration != nil {
+				scp.Status.Migration.Phase = v1alpha2.DeletingSourceMigrationPhase
+			}
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	deleted, err := r.deleteMigrationTenantControlPlane(ctx, *scp, migration.Source, tcp.UID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !deleted {
+		return migrationRetryAfter, nil
+	}
+
+	ctrllog.FromContext(ctx).Info("TenantControlPlane migration has been completed", "duration", time.Since(migration.StartTime.Time).String())
+
+	if err = r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		setHosting(scp, *migration.Destination.DeepCopy())
+		scp.Status.Migration = nil
+	}); err != nil {
+		return 0, err
+	}
+	// Migrated back to the management cluster, the external cluster finalizer is no longer required.
+	if migration.Destination.ExternalClusterReference == nil {
+		return 0, r.removeExternalClusterReferenceFinalizer(ctx, scp)
+	}
+
+	return 0, nil
+}
+
+// deleteMigrationTenantControlPlane deletes the TenantControlPlane left by the migration, such as the source one once completed,
+// or the destination one once rolled back, returning true once it's gone: the Steward DataStore finalizers are removed,
+// since they would delete the DataStore schema shared with the preserved TenantControlPlane.
+// Any other Steward finalizer could be a renamed DataStore one, such as after a Steward upgrade: the deletion is refused.
+func (r *StewardControlPlaneReconciler) deleteMigrationTenantControlPlane(ctx context.Context, scp v1alpha2.StewardControlPlane, hosting v1alpha2.HostingStatus, preservedUID types.UID) (bool, error) {
+	k8sClient, err := r.hostingClient(ctx, scp, hosting)
+	if err != nil {
+		return false, errors.Wrap(err, "cannot retrieve the hosting cluster client")
+	}
+
+	tcp := &stewardv1alpha1.TenantControlPlane{}
+	if err = k8sClient.Get(ctx, types.NamespacedName{Name: hosting.TenantControlPlaneName, Namespace: hosting.TenantControlPlaneNamespace}, tcp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, errors.Wrap(err, "cannot retrieve the migrated TenantControlPlane")
+	}
+	// The source and destination TenantControlPlane are the same one, such as when referencing the same cluster differently.
+	if tcp.UID == preservedUID {
+		return true, nil
+	}
+
+	for _, finalizer := range tcp.Finalizers {
+		if strings.HasPrefix(finalizer, stewardfinalizers.DatastoreFinalizer) && !migrationKnownStewardFinalizers.Has(finalizer) {
+			return false, fmt.Errorf("%w: %s", ErrMigrationUnknownFinalizer, finalizer)
+		}
+	}
+
+	if configName := tcp.Status.Storage.Config.SecretName; configName != "" {
+		config := &corev1.Secret{}
+		if err = k8sClient.Get(ctx, types.NamespacedName{Name: configName, Namespace: tcp.Namespace}, config); client.IgnoreNotFound(err) != nil {
+			return false, errors.Wrap(err, "cannot retrieve the migrated DataStore configuration")
+		}
+
+		if err == nil && controllerutil.RemoveFinalizer(config, stewardfinalizers.DatastoreSecretFinalizer) {
+			if err = k8sClient.Update(ctx, config); err != nil {
+				return false, errors.Wrap(err, "cannot remove the finalizer from the migrated DataStore configuration")
+			}
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(tcp, stewardfinalizers.DatastoreFinalizer) {
+		if err = k8sClient.Update(ctx, tcp); err != nil {
+			return false, errors.Wrap(err, "cannot remove the DataStore finalizer from the migrated TenantControlPlane")
+		}
+	}
+
+	if tcp.DeletionTimestamp.IsZero() {
+		if err = k8sClient.Delete(ctx, tcp); client.IgnoreNotFound(err) != nil {
+			return false, errors.Wrap(err, "cannot delete the migrated TenantControlPlane")
+		}
+	}
+
+	return false, nil
+}
+
+// ensureMigrationEndpoint ensures the endpoint of the destination TenantControlPlane is the one advertised by the Cluster:
+// the kubelet and kube-proxy kubeconfigs of the existing nodes point to it, and wouldn't be switched to a different one.
+func ensureMigrationEndpoint(cluster capiv1beta1.Cluster, endpoint capiv1beta1.APIEndpoint) error {
+	if current := cluster.Spec.ControlPlaneEndpoint; current.IsValid() && current != endpoint {
+		return fmt.Errorf("%w: %s rather than %s", ErrMigrationEndpointChanged, endpoint.String(), current.String())
+	}
+
+	return nil
+}
+
+// setMigrationCondition reports the progress of the TenantControlPlane migration.
+func setMigrationCondition(conditions *[]metav1.Condition, scp v1alpha2.StewardControlPlane, err error) {
+	condition := metav1.Condition{
+		Type:               string(v1alpha2.TenantControlPlaneMigratedConditionType),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: scp.Generation,
+	}
+
+	current := meta.FindStatusCondition(*conditions, condition.Type)
+
+	switch migration := scp.Status.Migration; {
+	case errors.Is(err, ErrMigrationRolledBack):
+		condition.Reason, condition.Message = migrationRolledBackReason, err.Error()
+	case err != nil && !errors.Is(err, ErrEnqueueBack):
+		condition.Reason, condition.Message = "Failed", err.Error()
+	case migration != nil:
+		condition.Reason = string(migration.Phase)
+		condition.Message = fmt.Sprintf("migrating from the %s TenantControlPlane to the %s one",
+			hostingName(migration.Source), hostingName(migration.Destination))
+	case current == nil || current.Reason == migrationRolledBackReason:
+		// Never migrated, or rolled back.
+		return
+	default:
+		condition.Status, condition.Reason = metav1.ConditionTrue, "Completed"
+	}
+
+	meta.SetStatusCondition(conditions, condition)
+}
+
+func hostingName(hosting v1alpha2.HostingStatus) string {
+	name := hosting.TenantControlPlaneNamespace + "/" + hosting.TenantControlPlaneName
+
+	if hosting.ExternalClusterReference == nil {
+		return name + " (management cluster)"
+	}
+
+	return name
+}
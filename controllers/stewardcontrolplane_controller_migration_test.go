@@ -0,0 +1,461 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	stewardfinalizers "github.com/butlerdotdev/steward/controllers/finalizers"
+	. "github.com/onsi/gomega" //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/component-base/featuregate"
+	"k8s.io/utils/ptr"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	ecr "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/features"
+)
+
+func testScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = capiv1beta1.AddToScheme(scheme)
+	_ = stewardv1alpha1.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	return scheme
+}
+
+// remoteManager serves the client of the external cluster, as the manager started by the ExternalClusterReference controller.
+type remoteManager struct {
+	ctrl.Manager
+
+	client client.Client
+}
+
+func (m remoteManager) GetClient() client.Client { //nolint:ireturn
+	return m.client
+}
+
+func (m remoteManager) GetRESTMapper() meta.RESTMapper { //nolint:ireturn
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(stewardv1alpha1.GroupVersion.WithKind("TenantControlPlane"), meta.RESTScopeNamespace)
+
+	return mapper
+}
+
+// migrationReconciler returns the reconciler for the management cluster with the given objects,
+// and the external cluster referenced by the given ExternalClusterReference with the remote objects.
+func migrationReconciler(t *testing.T, ref *v1alpha2.ExternalClusterReference, objects []client.Object, remoteObjects ...client.Object) (*StewardControlPlaneReconciler, client.Client) {
+	t.Helper()
+
+	g := NewWithT(t)
+	scheme := testScheme()
+
+	kubeconfig := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: ref.KubeconfigSecretName, Namespace: "default"},
+		Data:       map[string][]byte{ref.KubeconfigSecretKey: []byte("kubeconfig")},
+	}
+
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kubeconfig).WithObjects(objects...)
+	for _, object := range objects {
+		if scp, ok := object.(*v1alpha2.StewardControlPlane); ok {
+			builder = builder.WithStatusSubresource(scp)
+		}
+	}
+
+	localClient := builder.Build()
+	remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(remoteObjects...).Build()
+
+	g.Expect(localClient.Get(context.Background(), client.ObjectKeyFromObject(kubeconfig), kubeconfig)).To(Succeed())
+
+	store := ecr.NewStore()
+	store.Add(ecr.GenerateKeyName("default", ref), kubeconfig.ResourceVersion, remoteManager{client: remoteClient}, func() {})
+
+	featureGates := featuregate.NewFeatureGate()
+	g.Expect(featureGates.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		features.ExternalClusterReference:               {Default: true},
+		features.ExternalClusterReferenceCrossNamespace: {Default: false},
+	})).To(Succeed())
+
+	return &StewardControlPlaneReconciler{
+		ExternalClusterReferenceStore: store,
+		FeatureGates:                  featureGates,
+		client:                        localClient,
+		recorder:                      record.NewFakeRecorder(10),
+	}, remoteClient
+}
+
+func hostingReference() *v1alpha2.ExternalClusterReference {
+	return &v1alpha2.ExternalClusterReference{
+		KubeconfigSecretName: "hosting-kubeconfig",
+		KubeconfigSecretKey:  "value",
+		DeploymentNamespace:  "tenants",
+	}
+}
+
+// migratingStewardControlPlane returns the StewardControlPlane hosted by the management cluster,
+// moved to the tenants Namespace of an external cluster.
+func migratingStewardControlPlane() *v1alpha2.StewardControlPlane {
+	scp := &v1alpha2.StewardControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default", UID: "6f1c5d0e"}}
+	scp.Spec.Deployment.ExternalClusterReference = hostingReference()
+	scp.Spec.ControlPlaneEndpoint = capiv1beta1.APIEndpoint{Host: "10.0.0.2", Port: 6443}
+	scp.Status.Hosting = &v1alpha2.HostingStatus{TenantControlPlaneName: "cluster", TenantControlPlaneNamespace: "default"}
+
+	return scp
+}
+
+func dataStore(driver stewardv1alpha1.Driver, endpoints ...string) *stewardv1alpha1.DataStore {
+	return &stewardv1alpha1.DataStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       stewardv1alpha1.DataStoreSpec{Driver: driver, Endpoints: endpoints},
+	}
+}
+
+func TestReconcileMigrationStart(t *testing.T) {
+	tests := []struct {
+		name          string
+		dataStoreName string
+		source        *stewardv1alpha1.DataStore
+		destination   *stewardv1alpha1.DataStore
+		err           error
+	}{
+		{name: "default DataStore"},
+		{
+			name:          "same DataStore",
+			dataStoreName: "default",
+			source:        dataStore(stewardv1alpha1.EtcdDriver, "etcd-0:2379", "etcd-1:2379"),
+			destination:   dataStore(stewardv1alpha1.EtcdDriver, "etcd-1:2379", "etcd-0:2379"),
+		},
+		{
+			name:          "missing DataStore",
+			dataStoreName: "default",
+			source:        dataStore(stewardv1alpha1.EtcdDriver, "etcd-0:2379"),
+			err:           ErrMigrationDataStoreNotFound,
+		},
+		{
+			name:          "different DataStore",
+			dataStoreName: "default",
+			source:        dataStore(stewardv1alpha1.EtcdDriver, "etcd-0:2379"),
+			destination:   dataStore(stewardv1alpha1.EtcdDriver, "etcd.tenants:2379"),
+			err:           ErrMigrationDataStoreMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			scp := migratingStewardControlPlane()
+
+			tcp := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+			tcp.Status.Certificates.CA.SecretName = "cluster-ca"
+			tcp.Status.Certificates.SA.SecretName = "cluster-sa-certificate"
+			tcp.Status.Certificates.FrontProxyCA.SecretName = "cluster-front-proxy-ca-certificate"
+			tcp.Status.Storage.Config.SecretName = "cluster-datastore-config"
+			tcp.Status.Storage.DataStoreName = tt.dataStoreName
+
+			objects := []client.Object{scp, tcp}
+			for _, name := range []string{"cluster-ca", "cluster-sa-certificate", "cluster-front-proxy-ca-certificate", "cluster-datastore-config"} {
+				objects = append(objects, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}, Data: map[string][]byte{"name": []byte(name)}})
+			}
+
+			if tt.source != nil {
+				objects = append(objects, tt.source)
+			}
+
+			var remoteObjects []client.Object
+			if tt.destination != nil {
+				remoteObjects = append(remoteObjects, tt.destination)
+			}
+
+			r, remoteClient := migrationReconciler(t, scp.Spec.Deployment.ExternalClusterReference, objects, remoteObjects...)
+			// The Secrets are seeded in the same pass the migration starts, before the destination TenantControlPlane is created.
+			err := r.reconcileMigrationStart(ctx, remoteClient, scp)
+			g.Expect(scp.Status.Migration).NotTo(BeNil())
+			g.Expect(scp.Status.Migration.Destination.TenantControlPlaneName).To(Equal("kcp-6f1c5d0e"))
+
+			var secret corev1.Secret
+
+			if tt.err != nil {
+				// The migration fails before seeding the Secrets, and before the destination TenantControlPlane is created.
+				g.Expect(err).To(MatchError(tt.err))
+				g.Expect(scp.Status.Migration.Phase).To(Equal(v1alpha2.SeedingSecretsMigrationPhase))
+				g.Expect(apierrors.IsNotFound(remoteClient.Get(ctx, types.NamespacedName{Name: "kcp-6f1c5d0e-ca", Namespace: "tenants"}, &secret))).To(BeTrue())
+
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scp.Status.Migration.Phase).To(Equal(v1alpha2.ProvisioningDestinationMigrationPhase))
+
+			for _, suffix := range []string{"-ca", "-sa-certificate", "-front-proxy-ca-certificate", "-datastore-config"} {
+				g.Expect(remoteClient.Get(ctx, types.NamespacedName{Name: "kcp-6f1c5d0e" + suffix, Namespace: "tenants"}, &secret)).To(Succeed())
+				g.Expect(secret.Data).To(HaveKeyWithValue("name", []byte("cluster"+suffix)))
+			}
+
+			var tcps stewardv1alpha1.TenantControlPlaneList
+			g.Expect(remoteClient.List(ctx, &tcps)).To(Succeed())
+			g.Expect(tcps.Items).To(BeEmpty())
+		})
+	}
+}
+
+func TestReconcileMigrationRollback(t *testing.T) {
+	for _, phase := range []v1alpha2.MigrationPhase{
+		v1alpha2.SeedingSecretsMigrationPhase,
+		v1alpha2.ProvisioningDestinationMigrationPhase,
+		v1alpha2.SwitchingEndpointMigrationPhase,
+		v1alpha2.DeletingSourceMigrationPhase,
+	} {
+		t.Run(string(phase), func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			// The ExternalClusterReference has been removed, restoring the management cluster as hosting cluster.
+			scp := migratingStewardControlPlane()
+			scp.Finalizers = []string{ExternalClusterReferenceFinalizer}
+			scp.Spec.Deployment.ExternalClusterReference = nil
+			scp.Status.Migration = &v1alpha2.MigrationStatus{
+				Source:      *scp.Status.Hosting,
+				Destination: v1alpha2.HostingStatus{ExternalClusterReference: hostingReference(), TenantControlPlaneName: "kcp-6f1c5d0e", TenantControlPlaneNamespace: "tenants"},
+				Phase:       phase,
+			}
+
+			source := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default", UID: "source"}}
+
+			destination := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{
+				Name:       "kcp-6f1c5d0e",
+				Namespace:  "tenants",
+				UID:        "destination",
+				Finalizers: []string{stewardfinalizers.DatastoreFinalizer},
+			}}
+			destination.Status.Storage.Config.SecretName = "kcp-6f1c5d0e-datastore-config"
+
+			config := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:       "kcp-6f1c5d0e-datastore-config",
+				Namespace:  "tenants",
+				Finalizers: []string{stewardfinalizers.DatastoreSecretFinalizer},
+			}}
+
+			r, remoteClient := migrationReconciler(t, hostingReference(), []client.Object{scp, source}, destination, config)
+
+			err := r.reconcileMigrationStart(ctx, nil, scp)
+			// The source TenantControlPlane could be already deleted.
+			if phase == v1alpha2.DeletingSourceMigrationPhase {
+				g.Expect(err).To(MatchError(ErrMigrationDestinationChanged))
+
+				return
+			}
+			// The destination TenantControlPlane is deleted, without the Steward finalizers dropping the shared DataStore schema.
+			g.Expect(err).To(MatchError(ErrEnqueueBack))
+			g.Expect(apierrors.IsNotFound(remoteClient.Get(ctx, client.ObjectKeyFromObject(destination), destination))).To(BeTrue())
+
+			g.Expect(remoteClient.Get(ctx, client.ObjectKeyFromObject(config), config)).To(Succeed())
+			g.Expect(config.Finalizers).To(BeEmpty())
+
+			err = r.reconcileMigrationStart(ctx, nil, scp)
+			g.Expect(err).To(MatchError(ErrMigrationRolledBack))
+			g.Expect(scp.Status.Migration).To(BeNil())
+			g.Expect(scp.Status.Hosting.TenantControlPlaneName).To(Equal("cluster"))
+			g.Expect(scp.Finalizers).NotTo(ContainElement(ExternalClusterReferenceFinalizer))
+			// The source TenantControlPlane is preserved.
+			g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(source), source)).To(Succeed())
+			// The rollback is reported, rather than a completed migration.
+			var conditions []metav1.Condition
+
+			setMigrationCondition(&conditions, *scp, err)
+			setMigrationCondition(&conditions, *scp, nil)
+
+			condition := meta.FindStatusCondition(conditions, string(v1alpha2.TenantControlPlaneMigratedConditionType))
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Reason).To(Equal(migrationRolledBackReason))
+		})
+	}
+}
+
+func TestReconcileMigrationProvisioning(t *testing.T) {
+	tests := []struct {
+		name     string
+		ingress  string
+		endpoint string
+		cluster  capiv1beta1.APIEndpoint
+		err      error
+	}{
+		{name: "same address", endpoint: "10.0.0.1:6443", cluster: capiv1beta1.APIEndpoint{Host: "10.0.0.1", Port: 6443}},
+		{name: "same Ingress hostname", ingress: "cluster.example.com", endpoint: "10.0.0.2:6443", cluster: capiv1beta1.APIEndpoint{Host: "cluster.example.com", Port: 443}},
+		{name: "Cluster endpoint not yet advertised", endpoint: "10.0.0.2:6443"},
+		{name: "different address", endpoint: "10.0.0.2:6443", cluster: capiv1beta1.APIEndpoint{Host: "10.0.0.1", Port: 6443}, err: ErrMigrationEndpointChanged},
+		{name: "endpoint not yet available", cluster: capiv1beta1.APIEndpoint{Host: "10.0.0.1", Port: 6443}, err: ErrEnqueueBack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scp := migratingStewardControlPlane()
+			scp.Status.Migration = &v1alpha2.MigrationStatus{
+				Source:      *scp.Status.Hosting,
+				Destination: v1alpha2.HostingStatus{ExternalClusterReference: scp.Spec.Deployment.ExternalClusterReference.DeepCopy(), TenantControlPlaneName: "kcp-6f1c5d0e", TenantControlPlaneNamespace: "tenants"},
+				Phase:       v1alpha2.ProvisioningDestinationMigrationPhase,
+			}
+
+			if tt.ingress != "" {
+				scp.Spec.Network.Ingress = &v1alpha2.IngressComponent{Hostname: tt.ingress}
+			}
+
+			cluster := capiv1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+			cluster.Spec.ControlPlaneEndpoint = tt.cluster
+
+			r := &StewardControlPlaneReconciler{
+				client:   fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(scp).WithStatusSubresource(scp).Build(),
+				recorder: record.NewFakeRecorder(10),
+			}
+
+			destination := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "kcp-6f1c5d0e", Namespace: "tenants"}}
+			destination.Status.Kubernetes.Version.Status = ptr.To(stewardv1alpha1.VersionReady)
+			destination.Status.ControlPlaneEndpoint = tt.endpoint
+
+			err := r.reconcileMigrationProvisioning(context.Background(), cluster, scp, destination)
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+				// The kubeconfig is not advertised, the migration can still be rolled back.
+				g.Expect(scp.Status.Migration.Phase).To(Equal(v1alpha2.ProvisioningDestinationMigrationPhase))
+
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scp.Status.Migration.Phase).To(Equal(v1alpha2.SwitchingEndpointMigrationPhase))
+		})
+	}
+}
+
+func TestReconcileMigrationCompletionSwitchingEndpoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster capiv1beta1.APIEndpoint
+		err     error
+	}{
+		{name: "same endpoint", cluster: capiv1beta1.APIEndpoint{Host: "10.0.0.2", Port: 6443}},
+		{name: "different endpoint", cluster: capiv1beta1.APIEndpoint{Host: "10.0.0.1", Port: 6443}, err: ErrMigrationEndpointChanged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			scp := migratingStewardControlPlane()
+			scp.Status.Migration = &v1alpha2.MigrationStatus{
+				Source:      *scp.Status.Hosting,
+				Destination: v1alpha2.HostingStatus{ExternalClusterReference: scp.Spec.Deployment.ExternalClusterReference.DeepCopy(), TenantControlPlaneName: "kcp-6f1c5d0e", TenantControlPlaneNamespace: "tenants"},
+				Phase:       v1alpha2.SwitchingEndpointMigrationPhase,
+			}
+
+			cluster := &capiv1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+			cluster.Spec.ControlPlaneEndpoint = tt.cluster
+
+			r := &StewardControlPlaneReconciler{
+				client:   fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(scp, cluster).WithStatusSubresource(scp).Build(),
+				recorder: record.NewFakeRecorder(10),
+			}
+			destination := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "kcp-6f1c5d0e", Namespace: "tenants", UID: "destination"}}
+
+			retryAfter, err := r.reconcileMigrationCompletion(ctx, *cluster, scp, destination)
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+				// The Cluster is never switched, the source TenantControlPlane keeps serving the existing nodes.
+				g.Expect(scp.Status.Migration.Phase).To(Equal(v1alpha2.SwitchingEndpointMigrationPhase))
+				g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+				g.Expect(cluster.Spec.ControlPlaneEndpoint).To(Equal(tt.cluster))
+
+				return
+			}
+			// The source TenantControlPlane is already gone, completing the migration.
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(retryAfter).To(BeZero())
+			g.Expect(scp.Status.Migration).To(BeNil())
+			g.Expect(scp.Status.Hosting.TenantControlPlaneName).To(Equal("kcp-6f1c5d0e"))
+		})
+	}
+}
+
+func TestDeleteMigrationTenantControlPlane(t *testing.T) {
+	// The deletion relies on the Steward finalizers names, since Steward has no supported way to skip the DataStore teardown:
+	// a Steward upgrade renaming them must be detected here, rather than deleting the DataStore content.
+	g := NewWithT(t)
+	g.Expect(stewardfinalizers.DatastoreFinalizer).To(Equal("finalizer.steward.butlerlabs.dev"))
+	g.Expect(stewardfinalizers.DatastoreSecretFinalizer).To(Equal("finalizer.steward.butlerlabs.dev/datastore-secret"))
+	g.Expect(stewardfinalizers.SootFinalizer).To(Equal("finalizer.steward.butlerlabs.dev/soot"))
+
+	tests := []struct {
+		name       string
+		finalizers []string
+		err        error
+	}{
+		{name: "DataStore finalizers", finalizers: []string{stewardfinalizers.DatastoreFinalizer, stewardfinalizers.SootFinalizer}},
+		{name: "unknown Steward finalizer", finalizers: []string{stewardfinalizers.DatastoreFinalizer, "finalizer.steward.butlerlabs.dev/datastore-schema"}, err: ErrMigrationUnknownFinalizer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			tcp := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default", UID: "source", Finalizers: tt.finalizers}}
+			tcp.Status.Storage.Config.SecretName = "cluster-datastore-config"
+
+			config := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:       "cluster-datastore-config",
+				Namespace:  "default",
+				Finalizers: []string{stewardfinalizers.DatastoreSecretFinalizer},
+			}}
+
+			r := &StewardControlPlaneReconciler{
+				client:   fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(tcp, config).Build(),
+				recorder: record.NewFakeRecorder(10),
+			}
+
+			hosting := v1alpha2.HostingStatus{TenantControlPlaneName: "cluster", TenantControlPlaneNamespace: "default"}
+
+			deleted, err := r.deleteMigrationTenantControlPlane(ctx, v1alpha2.StewardControlPlane{}, hosting, "destination")
+			g.Expect(deleted).To(BeFalse())
+
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+				// Nothing is touched, leaving the DataStore content to Steward only once the finalizers are known.
+				g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(tcp), tcp)).To(Succeed())
+				g.Expect(tcp.DeletionTimestamp).To(BeNil())
+				g.Expect(tcp.Finalizers).To(ConsistOf(tt.finalizers))
+
+				g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(config), config)).To(Succeed())
+				g.Expect(config.Finalizers).To(ConsistOf(stewardfinalizers.DatastoreSecretFinalizer))
+
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			// The TenantControlPlane is left to the soot finalizer only, which doesn't touch the DataStore.
+			g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(tcp), tcp)).To(Succeed())
+			g.Expect(tcp.DeletionTimestamp).NotTo(BeNil())
+			g.Expect(tcp.Finalizers).To(ConsistOf(stewardfinalizers.SootFinalizer))
+
+			g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(config), config)).To(Succeed())
+			g.Expect(config.Finalizers).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	ecr "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/indexers"
+)
+
+const (
+	// ManagedNamespaceLabel marks the deployment Namespaces created by the Control Plane Provider,
+	// the only ones being updated and deleted.
+	ManagedNamespaceLabel = "ecr.steward.butlerlabs.dev/managed-namespace"
+	// managedNamespaceResourceName is the name of the ResourceQuota and LimitRange objects in the deployment Namespace.
+	managedNamespaceResourceName = "steward-tenant-control-planes"
+)
+
+var ErrDeploymentNamespaceNotManaged = errors.New("the deployment Namespace already exists and is not managed by the Control Plane Provider")
+
+// reconcileDeploymentNamespace creates the deployment Namespace in the external cluster, along with its ResourceQuota and LimitRange:
+// a pre-existing Namespace is left untouched.
+func (r *StewardControlPlaneReconciler) reconcileDeploymentNamespace(ctx context.Context, remoteClient client.Client, scp v1alpha2.StewardControlPlane) error {
+	spec := scp.Spec.Deployment.ExternalClusterReference.ManagedNamespace
+
+	ns := &corev1.Namespace{}
+	ns.Name = scp.Spec.Deployment.ExternalClusterReference.DeploymentNamespace
+
+	if err := remoteClient.Get(ctx, types.NamespacedName{Name: ns.Name}, ns); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "cannot retrieve the deployment Namespace")
+	}
+
+	if !ns.CreationTimestamp.IsZero() && ns.Labels[ManagedNamespaceLabel] != "true" {
+		return fmt.Errorf("%w: %s", ErrDeploymentNamespaceNotManaged, ns.Name)
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, scopeErr := controllerutil.CreateOrUpdate(ctx, remoteClient, ns, func() error {
+			labels := ns.Labels
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			for k, v := range spec.Labels {
+				labels[k] = v
+			}
+
+			labels[ManagedNamespaceLabel] = "true"
+
+			ns.SetLabels(labels)
+
+			if len(spec.Annotations) > 0 {
+				annotations := ns.Annotations
+				if annotations == nil {
+					annotations = map[string]string{}
+				}
+
+				for k, v := range spec.Annotations {
+					annotations[k] = v
+				}
+
+				ns.SetAnnotations(annotations)
+			}
+
+			return nil
+		})
+
+		return scopeErr //nolint:wrapcheck
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot create or update the deployment Namespace")
+	}
+
+	quota := &corev1.ResourceQuota{}
+	quota.Name, quota.Namespace = managedNamespaceResourceName, ns.Name
+
+	if err = reconcileManagedNamespaceObject(ctx, remoteClient, quota, spec.ResourceQuota != nil, func() {
+		quota.Spec = *spec.ResourceQuota
+	}); err != nil {
+		return errors.Wrap(err, "cannot reconcile the deployment Namespace ResourceQuota")
+	}
+
+	limitRange := &corev1.LimitRange{}
+	limitRange.Name, limitRange.Namespace = managedNamespaceResourceName, ns.Name
+
+	if err = reconcileManagedNamespaceObject(ctx, remoteClient, limitRange, spec.LimitRange != nil, func() {
+		limitRange.Spec = *spec.LimitRange
+	}); err != nil {
+		return errors.Wrap(err, "cannot reconcile the deployment Namespace LimitRange")
+	}
+
+	return nil
+}
+
+// reconcileManagedNamespaceObject creates or updates the given object when enabled, deleting it otherwise.
+func reconcileManagedNamespaceObject(ctx context.Context, remoteClient client.Client, object client.Object, enabled bool, mutateFn func()) error {
+	if !enabled {
+		if err := remoteClient.Delete(ctx, object); err != nil && !apierrors.IsNotFound(err) {
+			return err //nolint:wrapcheck
+		}
+
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error { //nolint:wrapcheck
+		_, scopeErr := controllerutil.CreateOrUpdate(ctx, remoteClient, object, func() error {
+			labels := object.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			labels[ManagedNamespaceLabel] = "true"
+
+			object.SetLabels(labels)
+
+			mutateFn()
+
+			return nil
+		})
+
+		return scopeErr //nolint:wrapcheck
+	})
+}
+
+// deleteDeploymentNamespace deletes the managed deployment Namespace from the external cluster,
+// once no other StewardControlPlane, or TenantControlPlane, is using it.
+func (r *StewardControlPlaneReconciler) deleteDeploymentNamespace(ctx context.Context, remoteClient client.Client, scp v1alpha2.StewardControlPlane) error {
+	log := ctrllog.FromContext(ctx)
+
+	key, err := remoteTenantControlPlaneKey(ctx, remoteClient, scp)
+	if err != nil {
+		return err
+	}
+
+	tcpName, namespace := key.Name, key.Namespace
+
+	var scpList v1alpha2.StewardControlPlaneList
+
+	if err := r.client.List(ctx, &scpList, client.MatchingFields{indexers.ExternalClusterReferenceStewardControlPlaneField: ecr.GenerateKeyNameFromSteward(&scp)}); err != nil {
+		return errors.Wrap(err, "cannot list the StewardControlPlanes using the external cluster")
+	}
+
+	for _, item := range scpList.Items {
+		if item.UID == scp.UID || !item.DeletionTimestamp.IsZero() || item.Spec.Deployment.ExternalClusterReference.DeploymentNamespace != namespace {
+			continue
+		}
+
+		log.Info("the deployment Namespace is still used by another StewardControlPlane, skipping its deletion", "namespace", namespace, "stewardcontrolplane", item.Name)
+
+		return nil
+	}
+
+	var tcpList stewardv1alpha1.TenantControlPlaneList
+
+	if err := remoteClient.List(ctx, &tcpList, client.InNamespace(namespace)); err != nil {
+		return errors.Wrap(err, "cannot list the TenantControlPlanes in the deployment Namespace")
+	}
+
+	for _, item := range tcpList.Items {
+		if item.Name == tcpName {
+			continue
+		}
+
+		log.Info("the deployment Namespace is still used by another TenantControlPlane, skipping its deletion", "namespace", namespace, "tenantcontrolplane", item.Name)
+
+		return nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := remoteClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return client.IgnoreNotFound(err) //nolint:wrapcheck
+	}
+
+	if ns.Labels[ManagedNamespaceLabel] != "true" || !ns.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if err := remoteClient.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "cannot delete the deployment Namespace")
+	}
+
+	log.Info("deployment Namespace has been deleted", "namespace", namespace)
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+const (
+	// RemediationRestartedAtAnnotation is added to the TenantControlPlane Pods to perform a rolling restart.
+	RemediationRestartedAtAnnotation = "steward.butlerlabs.dev/restarted-at"
+	// RemediationReconcileRequestedAtAnnotation is added to the TenantControlPlane to trigger the Steward reconciliation.
+	RemediationReconcileRequestedAtAnnotation = "steward.butlerlabs.dev/reconcile-requested-at"
+)
+
+var ErrRemediationExhausted = errors.New("remediation attempts exhausted")
+
+// reconcileRemediation tracks the TenantControlPlane in a non-ready version status, and remediates it according to the policy:
+// the returned duration is the time to wait for the next remediation check, zero if there's nothing to wait for.
+func (r *StewardControlPlaneReconciler) reconcileRemediation(ctx context.Context, remoteClient client.Client, scp *scpv1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) (time.Duration, error) { //nolint:cyclop
+	policy := scp.Spec.Remediation
+	if policy == nil {
+		return 0, nil
+	}
+
+	now, status := time.Now(), ptr.Deref(scp.Status.Remediation, scpv1alpha2.RemediationStatus{})
+	// Values are defaulted by the API server, tolerating missing ones.
+	timeout, retryPeriod := ptr.Deref(policy.Timeout, metav1.Duration{}).Duration, ptr.Deref(policy.RetryPeriod, metav1.Duration{}).Duration
+
+	if versionStatus := tcp.Status.Kubernetes.Version.Status; versionStatus != nil && *versionStatus == stewardv1alpha1.VersionReady {
+		if status.NotReadySince == nil && status.RetryCount == 0 {
+			return 0, nil
+		}
+		// The TenantControlPlane recovered: resetting the attempts, and keeping track of the last remediation.
+		return 0, r.updateStewardControlPlaneStatus(ctx, scp, func() {
+			scp.Status.Remediation = &scpv1alpha2.RemediationStatus{
+				LastRemediationTime: status.LastRemediationTime,
+				LastAction:          status.LastAction,
+			}
+		})
+	}
+
+	// The TenantControlPlane is legitimately non-ready while an operation is in progress, such as an upgrade:
+	// the timeout starts only once the operation is over.
+	if isTenantControlPlaneTransitioning(tcp) {
+		if status.NotReadySince == nil {
+			return 0, nil
+		}
+
+		return 0, r.updateStewardControlPlaneStatus(ctx, scp, func() {
+			status.NotReadySince = nil
+			scp.Status.Remediation = &status
+		})
+	}
+
+	if status.NotReadySince == nil {
+		if err := r.updateStewardControlPlaneStatus(ctx, scp, func() {
+			status.NotReadySince = &metav1.Time{Time: now}
+			scp.Status.Remediation = &status
+		}); err != nil {
+			return 0, err
+		}
+
+		return timeout, nil
+	}
+
+	if wait := timeout - now.Sub(status.NotReadySince.Time); wait > 0 {
+		return wait, nil
+	}
+
+	if status.LastRemediationTime != nil {
+		if wait := retryPeriod - now.Sub(status.LastRemediationTime.Time); wait > 0 {
+			return wait, nil
+		}
+	}
+
+	if status.RetryCount >= ptr.Deref(policy.MaxRetries, 0) {
+		return 0, fmt.Errorf("TenantControlPlane not ready since %s, %w after %d attempts", status.NotReadySince.Format(time.RFC3339), ErrRemediationExhausted, status.RetryCount)
+	}
+
+	ctrllog.FromContext(ctx).Info("remediating the TenantControlPlane", "action", policy.Action, "attempt", status.RetryCount+1)
+
+	status.RetryCount++
+	status.LastRemediationTime = &metav1.Time{Time: now}
+	status.LastAction = policy.Action
+
+	k8sClient := r.client
+	if remoteClient != nil {
+		k8sClient = remoteClient
+	}
+	// The attempt is recorded only once applied, the failed ones are retried without consuming the attempts.
+	attempt := scp.DeepCopy()
+	attempt.Status.Remediation = &status
+
+	original := tcp.DeepCopy()
+	applyRemediation(tcp, *attempt)
+
+	if err := k8sClient.Patch(ctx, tcp, client.MergeFrom(original)); err != nil {
+		return 0, errors.Wrap(err, "cannot remediate the TenantControlPlane")
+	}
+
+	if err := r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		scp.Status.Remediation = &status
+	}); err != nil {
+		return 0, err
+	}
+
+	return retryPeriod, nil
+}
+
+// isTenantControlPlaneTransitioning returns true when the TenantControlPlane is non-ready due to an operation in progress.
+func isTenantControlPlaneTransitioning(tcp *stewardv1alpha1.TenantControlPlane) bool {
+	versionStatus := tcp.Status.Kubernetes.Version.Status
+	if versionStatus == nil {
+		return false
+	}
+
+	switch *versionStatus {
+	case stewardv1alpha1.VersionProvisioning, stewardv1alpha1.VersionUpgrading, stewardv1alpha1.VersionMigrating,
+		stewardv1alpha1.VersionCARotating, stewardv1alpha1.VersionSleeping:
+		return true
+	default:
+		return false
+	}
+}
+
+// applyRemediation propagates the last remediation attempt to the TenantControlPlane:
+// the value is derived from the status, keeping it stable across the reconciliations.
+func applyRemediation(tcp *stewardv1alpha1.TenantControlPlane, scp scpv1alpha2.StewardControlPlane) {
+	status := scp.Status.Remediation
+	if status == nil || status.LastRemediationTime == nil {
+		return
+	}
+
+	value := status.LastRemediationTime.UTC().Format(time.RFC3339)
+
+	switch status.LastAction {
+	case scpv1alpha2.RollingRestartRemediationAction:
+		// Cloning the map since it could be shared with the StewardControlPlane one.
+		annotations := maps.Clone(tcp.Spec.ControlPlane.Deployment.PodAdditionalMetadata.Annotations)
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+
+		annotations[RemediationRestartedAtAnnotation] = value
+		tcp.Spec.ControlPlane.Deployment.PodAdditionalMetadata.Annotations = annotations
+	case scpv1alpha2.ReconcileTriggerRemediationAction:
+		if tcp.Annotations == nil {
+			tcp.Annotations = make(map[string]string)
+		}
+
+		tcp.Annotations[RemediationReconcileRequestedAtAnnotation] = value
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	. "github.com/onsi/gomega" //nolint:revive
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+func TestReconcileRemediation(t *testing.T) {
+	const (
+		timeout     = 10 * time.Minute
+		retryPeriod = 5 * time.Minute
+	)
+
+	ago := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: time.Now().Add(-d).Truncate(time.Second)}
+	}
+
+	policy := &scpv1alpha2.RemediationSpec{
+		Timeout:     &metav1.Duration{Duration: timeout},
+		RetryPeriod: &metav1.Duration{Duration: retryPeriod},
+		MaxRetries:  ptr.To[int32](2),
+		Action:      scpv1alpha2.ReconcileTriggerRemediationAction,
+	}
+
+	tests := []struct {
+		name          string
+		policy        *scpv1alpha2.RemediationSpec
+		versionStatus stewardv1alpha1.KubernetesVersionStatus
+		status        *scpv1alpha2.RemediationStatus
+		// expected is the remediation status, nil when not expected to be changed.
+		expected   *scpv1alpha2.RemediationStatus
+		wait       time.Duration
+		err        error
+		remediated bool
+	}{
+		{name: "no policy", versionStatus: stewardv1alpha1.VersionNotReady},
+		{name: "ready", policy: policy, versionStatus: stewardv1alpha1.VersionReady},
+		{
+			name:          "recovered",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionReady,
+			status:        &scpv1alpha2.RemediationStatus{NotReadySince: ago(time.Hour), RetryCount: 1, LastRemediationTime: ago(time.Minute), LastAction: policy.Action},
+			expected:      &scpv1alpha2.RemediationStatus{LastRemediationTime: ago(time.Minute), LastAction: policy.Action},
+		},
+		{
+			name:          "transitioning",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionUpgrading,
+			status:        &scpv1alpha2.RemediationStatus{NotReadySince: ago(time.Hour)},
+			expected:      &scpv1alpha2.RemediationStatus{},
+		},
+		{
+			name:          "first observed not ready",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionNotReady,
+			wait:          timeout,
+		},
+		{
+			name:          "within the timeout",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionNotReady,
+			status:        &scpv1alpha2.RemediationStatus{NotReadySince: ago(timeout / 2)},
+			wait:          timeout / 2,
+		},
+		{
+			name:          "timeout expired",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionNotReady,
+			status:        &scpv1alpha2.RemediationStatus{NotReadySince: ago(timeout)},
+			wait:          retryPeriod,
+			remediated:    true,
+		},
+		{
+			name:          "within the retry period",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionNotReady,
+			status:        &scpv1alpha2.RemediationStatus{NotReadySince: ago(time.Hour), RetryCount: 1, LastRemediationTime: ago(retryPeriod / 2), LastAction: policy.Action},
+			wait:          retryPeriod / 2,
+		},
+		{
+			name:          "retry period expired",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionNotReady,
+			status:        &scpv1alpha2.RemediationStatus{NotReadySince: ago(time.Hour), RetryCount: 1, LastRemediationTime: ago(retryPeriod), LastAction: policy.Action},
+			wait:          retryPeriod,
+			remediated:    true,
+		},
+		{
+			name:          "max retries reached",
+			policy:        policy,
+			versionStatus: stewardv1alpha1.VersionNotReady,
+			status:        &scpv1alpha2.RemediationStatus{NotReadySince: ago(time.Hour), RetryCount: 2, LastRemediationTime: ago(retryPeriod), LastAction: policy.Action},
+			err:           ErrRemediationExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			scp := &scpv1alpha2.StewardControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+			scp.Spec.Remediation = tt.policy
+			scp.Status.Remediation = tt.status.DeepCopy()
+
+			tcp := &stewardv1alpha1.TenantControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+			tcp.Status.Kubernetes.Version.Status = ptr.To(tt.versionStatus)
+
+			r := &StewardControlPlaneReconciler{
+				client:   fake.NewClientBuilder().WithScheme(testScheme()).WithObjects(scp, tcp).WithStatusSubresource(scp).Build(),
+				recorder: record.NewFakeRecorder(10),
+			}
+
+			wait, err := r.reconcileRemediation(ctx, nil, scp, tcp)
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			// The elapsed time is rounded to the second by the serialization.
+			g.Expect(wait).To(BeNumerically("~", tt.wait, 2*time.Second))
+
+			var current scpv1alpha2.StewardControlPlane
+			g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(scp), &current)).To(Succeed())
+
+			switch {
+			case tt.remediated:
+				g.Expect(current.Status.Remediation.RetryCount).To(Equal(tt.status.RetryCount + 1))
+				g.Expect(current.Status.Remediation.LastAction).To(Equal(policy.Action))
+				g.Expect(current.Status.Remediation.LastRemediationTime.Time).To(BeTemporally("~", time.Now(), 2*time.Second))
+				g.Expect(current.Status.Remediation.NotReadySince).To(Equal(tt.status.NotReadySince))
+			case tt.expected != nil:
+				g.Expect(current.Status.Remediation).To(Equal(tt.expected))
+			case tt.policy != nil && tt.status == nil && tt.versionStatus != stewardv1alpha1.VersionReady:
+				g.Expect(current.Status.Remediation.NotReadySince.Time).To(BeTemporally("~", time.Now(), 2*time.Second))
+			default:
+				g.Expect(current.Status.Remediation).To(Equal(tt.status))
+			}
+
+			var patched stewardv1alpha1.TenantControlPlane
+			g.Expect(r.client.Get(ctx, client.ObjectKeyFromObject(tcp), &patched)).To(Succeed())
+
+			if tt.remediated {
+				g.Expect(patched.Annotations).To(HaveKeyWithValue(RemediationReconcileRequestedAtAnnotation, current.Status.Remediation.LastRemediationTime.UTC().Format(time.RFC3339)))
+			} else {
+				g.Expect(patched.Annotations).NotTo(HaveKey(RemediationReconcileRequestedAtAnnotation))
+			}
+		})
+	}
+}
+
+func TestApplyRemediationRollingRestart(t *testing.T) {
+	g := NewWithT(t)
+
+	shared := map[string]string{"owner": "platform"}
+
+	scp := scpv1alpha2.StewardControlPlane{}
+	scp.Status.Remediation = &scpv1alpha2.RemediationStatus{
+		LastRemediationTime: &metav1.Time{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		LastAction:          scpv1alpha2.RollingRestartRemediationAction,
+	}
+
+	tcp := &stewardv1alpha1.TenantControlPlane{}
+	tcp.Spec.ControlPlane.Deployment.PodAdditionalMetadata.Annotations = shared
+
+	applyRemediation(tcp, scp)
+	g.Expect(tcp.Spec.ControlPlane.Deployment.PodAdditionalMetadata.Annotations).To(HaveKeyWithValue(RemediationRestartedAtAnnotation, "2025-01-01T00:00:00Z"))
+	// The map shared with the StewardControlPlane isn't changed.
+	g.Expect(shared).NotTo(HaveKey(RemediationRestartedAtAnnotation))
+}
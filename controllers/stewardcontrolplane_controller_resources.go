@@ -6,25 +6,28 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/retry"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
-	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 )
 
 var ErrEnqueueBack = errors.New("enqueue back")
 
-//+kubebuilder:rbac:groups="",resources="secrets",verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups="",resources="secrets",verbs=get;list;watch;create;update;patch;delete
 
-func (r *StewardControlPlaneReconciler) createRequiredResources(ctx context.Context, remoteClient client.Client, cluster capiv1beta1.Cluster, scp v1alpha1.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+func (r *StewardControlPlaneReconciler) createRequiredResources(ctx context.Context, remoteClient client.Client, cluster capiv1beta1.Cluster, scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
 	log := ctrllog.FromContext(ctx)
 	// Creating a kubeconfig secret for the workload cluster.
 	if secretName := tcp.Status.KubeConfig.Admin.SecretName; len(secretName) == 0 {
@@ -64,7 +67,12 @@ func (r *StewardControlPlaneReconciler) createRequiredResources(ctx context.Cont
 // also in regard to the naming conventions according to the Cluster API contracts about Kubeconfig.
 //
 // more info: https://cluster-api.sigs.k8s.io/developer/architecture/controllers/cluster.html#secrets
-func (r *StewardControlPlaneReconciler) createOrUpdateCertificateAuthority(ctx context.Context, reader client.Client, cluster capiv1beta1.Cluster, scp v1alpha1.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+func (r *StewardControlPlaneReconciler) createOrUpdateCertificateAuthority(ctx context.Context, reader client.Client, cluster capiv1beta1.Cluster, scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+	// The provided Certificate Authority is the source of truth, seeded into Steward rather than replicated from it.
+	if isCertificateAuthorityProvided(scp) {
+		return nil
+	}
+
 	capiCA := &corev1.Secret{}
 	capiCA.Name = cluster.Name + "-ca"
 	capiCA.Namespace = cluster.Namespace
@@ -93,6 +101,20 @@ func (r *StewardControlPlaneReconciler) createOrUpdateCertificateAuthority(ctx c
 			if !found {
 				return errors.New("missing Private Key value from *stewardv1alpha1.TenantControlPlane CA")
 			}
+			// Withholding the private key, removed from the previously replicated Secret too:
+			// a placeholder one keeps the worker nodes join performed by the kubeadm bootstrap provider working.
+			if isCertificateAuthorityKeyWithheld(scp) {
+				var keyErr error
+
+				if key, keyErr = workerJoinPlaceholderKey(capiCA.Data[corev1.TLSPrivateKeyKey], crt); keyErr != nil {
+					return keyErr
+				}
+			}
+
+			capiCA.Data = map[string][]byte{
+				corev1.TLSCertKey:       crt,
+				corev1.TLSPrivateKeyKey: key,
+			}
 
 			labels := stewardCA.Labels
 			if labels == nil {
@@ -106,11 +128,6 @@ func (r *StewardControlPlaneReconciler) createOrUpdateCertificateAuthority(ctx c
 			labels["steward.butlerlabs.dev/tcp"] = tcp.Name
 
 			capiCA.SetLabels(labels)
-
-			capiCA.Data = map[string][]byte{
-				corev1.TLSCertKey:       crt,
-				corev1.TLSPrivateKeyKey: key,
-			}
 			// Only set Type on creation - Secret types are immutable
 			if capiCA.CreationTimestamp.IsZero() {
 				capiCA.Type = capiv1beta1.ClusterSecretType
@@ -132,7 +149,7 @@ func (r *StewardControlPlaneReconciler) createOrUpdateCertificateAuthority(ctx c
 // also in regard to the naming conventions according to the Cluster API contracts about kubeconfig.
 //
 // more info: https://cluster-api.sigs.k8s.io/developer/architecture/controllers/cluster.html#secrets
-func (r *StewardControlPlaneReconciler) createOrUpdateKubeconfig(ctx context.Context, reader client.Client, cluster capiv1beta1.Cluster, scp v1alpha1.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+func (r *StewardControlPlaneReconciler) createOrUpdateKubeconfig(ctx context.Context, reader client.Client, cluster capiv1beta1.Cluster, scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
 	capiAdminKubeconfig := &corev1.Secret{}
 	capiAdminKubeconfig.Name = cluster.Name + "-kubeconfig"
 	capiAdminKubeconfig.Namespace = cluster.Namespace
@@ -145,7 +162,12 @@ func (r *StewardControlPlaneReconciler) createOrUpdateKubeconfig(ctx context.Con
 		return errors.Wrap(err, "cannot retrieve source-of-truth for admin kubeconfig")
 	}
 
-	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	server, err := r.kubeconfigServer(scp, tcp)
+	if err != nil {
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		_, scopeErr := controllerutil.CreateOrUpdate(ctx, r.client, capiAdminKubeconfig, func() error {
 			labels := capiAdminKubeconfig.Labels
 			if labels == nil {
@@ -158,16 +180,18 @@ func (r *StewardControlPlaneReconciler) createOrUpdateKubeconfig(ctx context.Con
 			labels["steward.butlerlabs.dev/cluster"] = cluster.Name
 			labels["steward.butlerlabs.dev/tcp"] = tcp.Name
 
-			secretKey := "admin.conf"
-			if v, ok := scp.GetAnnotations()[stewardv1alpha1.KubeconfigSecretKeyAnnotation]; ok && v != "" {
-				secretKey = v
-			}
-
-			value, ok := stewardAdminKubeconfig.Data[secretKey]
+			value, ok := stewardAdminKubeconfig.Data[kubeconfigSecretKey(scp)]
 			if !ok {
 				return errors.New("missing key from *stewardv1alpha1.TenantControlPlane admin kubeconfig secret")
 			}
 
+			if server != "" {
+				var rewriteErr error
+				if value, rewriteErr = rewriteKubeconfigServer(value, server); rewriteErr != nil {
+					return rewriteErr
+				}
+			}
+
 			capiAdminKubeconfig.SetLabels(labels)
 
 			capiAdminKubeconfig.Data = map[string][]byte{
@@ -189,3 +213,57 @@ func (r *StewardControlPlaneReconciler) createOrUpdateKubeconfig(ctx context.Con
 
 	return nil
 }
+
+// kubeconfigSecretKey returns the key of the Steward admin kubeconfig Secret to replicate, overridable with an annotation.
+func kubeconfigSecretKey(scp v1alpha2.StewardControlPlane) string {
+	if v, ok := scp.GetAnnotations()[stewardv1alpha1.KubeconfigSecretKeyAnnotation]; ok && v != "" {
+		return v
+	}
+
+	return "admin.conf"
+}
+
+// kubeconfigServer returns the server the kubeconfig Secrets must point to, empty if the Steward one must be kept.
+func (r *StewardControlPlaneReconciler) kubeconfigServer(scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) (string, error) {
+	if scp.Spec.KubeconfigServer == nil {
+		return "", nil
+	}
+
+	if scp.Spec.KubeconfigServer.URL != "" {
+		return scp.Spec.KubeconfigServer.URL, nil
+	}
+
+	endpoint, port, err := r.controlPlaneEndpoint(&scp, tcp.Status.ControlPlaneEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot retrieve ControlPlaneEndpoint")
+	}
+
+	return "https://" + net.JoinHostPort(endpoint, strconv.FormatInt(port, 10)), nil
+}
+
+// rewriteKubeconfigServer replaces the server of the cluster referenced by the kubeconfig current context.
+func rewriteKubeconfigServer(value []byte, server string) ([]byte, error) {
+	config, err := clientcmd.Load(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decode *stewardv1alpha1.TenantControlPlane admin kubeconfig")
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, errors.New("missing current context from *stewardv1alpha1.TenantControlPlane admin kubeconfig")
+	}
+
+	kubeCluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, errors.New("missing cluster from *stewardv1alpha1.TenantControlPlane admin kubeconfig")
+	}
+
+	kubeCluster.Server = server
+
+	value, err = clientcmd.Write(*config)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot encode the rewritten admin kubeconfig")
+	}
+
+	return value, nil
+}
@@ -19,20 +19,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
-	scpv1alpha1 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/upgrade"
 )
 
 var ErrUnsupportedCertificateSAN = errors.New("a certificate SAN must be made of host only with no port")
 
-//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanes,verbs=get;list;watch;create;update
+//+kubebuilder:rbac:groups=steward.butlerlabs.dev,resources=tenantcontrolplanes,verbs=get;list;watch;create;update;patch;delete
 
 //nolint:funlen,gocognit,cyclop,maintidx
-func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx context.Context, remoteClient client.Client, cluster capiv1beta1.Cluster, scp scpv1alpha1.StewardControlPlane) (*stewardv1alpha1.TenantControlPlane, error) {
+func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx context.Context, remoteClient client.Client, cluster capiv1beta1.Cluster, scp scpv1alpha2.StewardControlPlane, upgradeBlocked bool) (*stewardv1alpha1.TenantControlPlane, error) {
 	tcp := &stewardv1alpha1.TenantControlPlane{}
 	tcp.Name = scp.GetName()
 	tcp.Namespace = scp.GetNamespace()
 
+	if remoteClient != nil {
+		key, keyErr := remoteTenantControlPlaneKey(ctx, remoteClient, scp)
+		if keyErr != nil {
+			return nil, keyErr
+		}
+
+		tcp.Name, tcp.Namespace = key.Name, key.Namespace
+	}
+
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		k8sClient := r.client
 
@@ -40,7 +50,6 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 
 		if isDelegatedExternally = remoteClient != nil; isDelegatedExternally {
 			k8sClient = remoteClient
-			tcp.Name, tcp.Namespace = externalclusterreference.GenerateRemoteTenantControlPlaneNames(scp)
 		}
 
 		_, scopeErr := controllerutil.CreateOrUpdate(ctx, k8sClient, tcp, func() error {
@@ -49,14 +58,36 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 			}
 
 			for k, v := range scp.Annotations {
-				if k == corev1.LastAppliedConfigAnnotation {
+				if k == corev1.LastAppliedConfigAnnotation || isStewardControlPlaneInternalAnnotation(k) {
 					continue
 				}
 
 				tcp.Annotations[k] = v
 			}
+			// Removing the ones propagated by the previous versions, which would be never removed otherwise.
+			for k := range tcp.Annotations {
+				if isStewardControlPlaneInternalAnnotation(k) {
+					delete(tcp.Annotations, k)
+				}
+			}
 
 			tcp.Labels = scp.Labels
+			// Labelling the remote TenantControlPlane, allowing its adoption regardless of the name.
+			if isDelegatedExternally {
+				tcp.Labels = make(map[string]string, len(scp.Labels)+2) //nolint:mnd
+
+				for k, v := range scp.Labels {
+					tcp.Labels[k] = v
+				}
+
+				for k, v := range externalclusterreference.StewardControlPlaneLabels(scp) {
+					tcp.Labels[k] = v
+				}
+
+				for k, v := range externalclusterreference.StewardControlPlaneAnnotations(scp) {
+					tcp.Annotations[k] = v
+				}
+			}
 
 			if kubeconfigSecretKey := scp.Annotations[stewardv1alpha1.KubeconfigSecretKeyAnnotation]; kubeconfigSecretKey != "" {
 				tcp.Annotations[stewardv1alpha1.KubeconfigSecretKeyAnnotation] = kubeconfigSecretKey
@@ -82,33 +113,48 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 			// Replicas
 			tcp.Spec.ControlPlane.Deployment.Replicas = scp.Spec.Replicas
 			// Version
-			// Tolerate version strings without a "v" prefix: prepend it if it's not there
-			if !strings.HasPrefix(scp.Spec.Version, "v") {
-				tcp.Spec.Kubernetes.Version = "v" + scp.Spec.Version
-			} else {
-				tcp.Spec.Kubernetes.Version = scp.Spec.Version
+			version := kubernetesVersion(scp)
+			// Enforcing the Kubernetes version skew policy, the desired version is applied only if allowed,
+			// and not blocked by the BeforeClusterUpgrade hook: both are reported by the KubernetesVersionUpgradeAllowed condition.
+			if !upgradeBlocked && upgrade.ValidateVersionChange(tcp.Status.Kubernetes.Version.Version, tcp.Spec.Kubernetes.Version, version) == nil {
+				tcp.Spec.Kubernetes.Version = version
 			}
 			// Set before CoreDNS addon to allow override.
 			tcp.Spec.NetworkProfile.DNSServiceIPs = scp.Spec.Network.DNSServiceIPs
 			// Steward addons and CoreDNS overrides
-			tcp.Spec.Addons = scp.Spec.Addons.AddonsSpec
+			tcp.Spec.Addons = stewardv1alpha1.AddonsSpec{
+				Konnectivity:    scp.Spec.Addons.Konnectivity,
+				KubeProxy:       scp.Spec.Addons.KubeProxy,
+				TCPProxy:        scp.Spec.Addons.TCPProxy,
+				WorkerBootstrap: scp.Spec.Addons.WorkerBootstrap,
+			}
 			if scp.Spec.Addons.CoreDNS != nil {
 				tcp.Spec.NetworkProfile.DNSServiceIPs = scp.Spec.Addons.CoreDNS.DNSServiceIPs
-
-				if scp.Spec.Addons.CoreDNS.AddonSpec == nil {
-					scp.Spec.Addons.CoreDNS.AddonSpec = &stewardv1alpha1.AddonSpec{}
-				}
-
-				tcp.Spec.Addons.CoreDNS = scp.Spec.Addons.CoreDNS.AddonSpec
+				tcp.Spec.Addons.CoreDNS = scp.Spec.Addons.CoreDNS.AddonSpec.DeepCopy()
 			}
 			// Steward specific options
-			tcp.Spec.DataStore = scp.Spec.DataStoreName
+			// An empty value keeps the assigned DataStore, such as the one shared with the source TenantControlPlane upon a migration.
+			if scp.Spec.DataStoreName != "" {
+				tcp.Spec.DataStore = scp.Spec.DataStoreName
+			}
 			if scp.Spec.DataStoreSchema != "" {
 				tcp.Spec.DataStoreSchema = scp.Spec.DataStoreSchema
 			}
 			if scp.Spec.DataStoreUsername != "" {
 				tcp.Spec.DataStoreUsername = scp.Spec.DataStoreUsername
 			}
+			// The migrated TenantControlPlane shares the DataStore schema and credentials of the source one.
+			if migration := scp.Status.Migration; migration != nil {
+				if tcp.Spec.DataStore == "" {
+					tcp.Spec.DataStore = migration.DataStoreName
+				}
+				if tcp.Spec.DataStoreSchema == "" {
+					tcp.Spec.DataStoreSchema = migration.DataStoreSchema
+				}
+				if tcp.Spec.DataStoreUsername == "" {
+					tcp.Spec.DataStoreUsername = migration.DataStoreUsername
+				}
+			}
 			tcp.Spec.Kubernetes.AdmissionControllers = scp.Spec.AdmissionControllers
 			tcp.Spec.ControlPlane.Deployment.RegistrySettings.Registry = scp.Spec.ContainerRegistry
 			// Volume mounts
@@ -118,7 +164,7 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 
 			tcp.Spec.ControlPlane.Deployment.AdditionalVolumeMounts.ControllerManager = scp.Spec.ControllerManager.ExtraVolumeMounts
 			tcp.Spec.ControlPlane.Deployment.AdditionalVolumeMounts.Scheduler = scp.Spec.Scheduler.ExtraVolumeMounts
-			tcp.Spec.ControlPlane.Deployment.AdditionalVolumeMounts.APIServer = scp.Spec.ApiServer.ExtraVolumeMounts
+			tcp.Spec.ControlPlane.Deployment.AdditionalVolumeMounts.APIServer = scp.Spec.APIServer.ExtraVolumeMounts
 			// Extra args
 			if tcp.Spec.ControlPlane.Deployment.ExtraArgs == nil {
 				tcp.Spec.ControlPlane.Deployment.ExtraArgs = &stewardv1alpha1.ControlPlaneExtraArgs{}
@@ -126,7 +172,7 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 
 			tcp.Spec.ControlPlane.Deployment.ExtraArgs.ControllerManager = scp.Spec.ControllerManager.ExtraArgs
 			tcp.Spec.ControlPlane.Deployment.ExtraArgs.Scheduler = scp.Spec.Scheduler.ExtraArgs
-			tcp.Spec.ControlPlane.Deployment.ExtraArgs.APIServer = scp.Spec.ApiServer.ExtraArgs
+			tcp.Spec.ControlPlane.Deployment.ExtraArgs.APIServer = scp.Spec.APIServer.ExtraArgs
 			tcp.Spec.ControlPlane.Deployment.ExtraArgs.Kine = scp.Spec.Kine.ExtraArgs
 			// Resources
 			if tcp.Spec.ControlPlane.Deployment.Resources == nil {
@@ -135,12 +181,12 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 
 			tcp.Spec.ControlPlane.Deployment.Resources.ControllerManager = &scp.Spec.ControllerManager.Resources
 			tcp.Spec.ControlPlane.Deployment.Resources.Scheduler = &scp.Spec.Scheduler.Resources
-			tcp.Spec.ControlPlane.Deployment.Resources.APIServer = &scp.Spec.ApiServer.Resources
+			tcp.Spec.ControlPlane.Deployment.Resources.APIServer = &scp.Spec.APIServer.Resources
 			tcp.Spec.ControlPlane.Deployment.Resources.Kine = &scp.Spec.Kine.Resources
 			// Container image overrides
 			tcp.Spec.ControlPlane.Deployment.RegistrySettings.ControllerManagerImage = scp.Spec.ControllerManager.ContainerImageName
 			tcp.Spec.ControlPlane.Deployment.RegistrySettings.SchedulerImage = scp.Spec.Scheduler.ContainerImageName
-			tcp.Spec.ControlPlane.Deployment.RegistrySettings.APIServerImage = scp.Spec.ApiServer.ContainerImageName
+			tcp.Spec.ControlPlane.Deployment.RegistrySettings.APIServerImage = scp.Spec.APIServer.ContainerImageName
 			// Kubelet
 			tcp.Spec.Kubernetes.Kubelet = scp.Spec.Kubelet
 			// Network
@@ -233,6 +279,8 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 			tcp.Spec.ControlPlane.Deployment.AdditionalInitContainers = scp.Spec.Deployment.ExtraInitContainers
 			tcp.Spec.ControlPlane.Deployment.AdditionalContainers = scp.Spec.Deployment.ExtraContainers
 			tcp.Spec.ControlPlane.Deployment.AdditionalVolumes = scp.Spec.Deployment.ExtraVolumes
+			// Preserving the last remediation, otherwise reverted by the Deployment metadata
+			applyRemediation(tcp, scp)
 
 			if !isDelegatedExternally {
 				return controllerutil.SetControllerReference(&scp, tcp, k8sClient.Scheme())
@@ -249,3 +297,16 @@ func (r *StewardControlPlaneReconciler) createOrUpdateTenantControlPlane(ctx con
 
 	return tcp, nil
 }
+
+// isStewardControlPlaneInternalAnnotation returns true for the annotations driving the StewardControlPlane reconciliation,
+// such as the Cluster API and the provider ones, which must not be propagated to the TenantControlPlane.
+func isStewardControlPlaneInternalAnnotation(key string) bool {
+	switch key {
+	case scpv1alpha2.RotateCertificatesAnnotation, scpv1alpha2.ForceDeleteAnnotation:
+		return true
+	}
+
+	domain, _, found := strings.Cut(key, "/")
+
+	return found && (domain == capiv1beta1.GroupVersion.Group || strings.HasSuffix(domain, "."+capiv1beta1.GroupVersion.Group))
+}
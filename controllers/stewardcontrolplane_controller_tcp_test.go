@@ -0,0 +1,37 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"testing"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	. "github.com/onsi/gomega" //nolint:revive
+	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+func TestIsStewardControlPlaneInternalAnnotation(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{key: runtimev1.PendingHooksAnnotation, expected: true},
+		{key: "cluster.x-k8s.io/paused", expected: true},
+		{key: "topology.cluster.x-k8s.io/held-versions", expected: true},
+		{key: scpv1alpha2.RotateCertificatesAnnotation, expected: true},
+		{key: scpv1alpha2.ForceDeleteAnnotation, expected: true},
+		{key: stewardv1alpha1.KubeconfigSecretKeyAnnotation},
+		{key: "example.com/owner"},
+		{key: "cluster.x-k8s.io.example.com/owner"},
+		{key: "description"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			NewWithT(t).Expect(isStewardControlPlaneInternalAnnotation(tt.key)).To(Equal(tt.expected))
+		})
+	}
+}
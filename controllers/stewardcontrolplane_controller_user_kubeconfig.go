@@ -0,0 +1,472 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/certificate/csr"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/client-go/util/retry"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+// UserKubeconfigChecksumAnnotation tracks the inputs used to generate the user kubeconfig, such as the identity
+// and the Certificate Authority: a change of them triggers the generation of a new kubeconfig.
+const UserKubeconfigChecksumAnnotation = "steward.butlerlabs.dev/user-kubeconfig-checksum"
+
+// userCertificateRequestKey is the user kubeconfig Secret key storing the private key of the pending certificate signing request,
+// until the certificate is issued by the workload cluster signer.
+const userCertificateRequestKey = "csr.key"
+
+// userCertificateRetryAfter is the delay between the checks of the pending certificate signing request.
+const userCertificateRetryAfter = 5 * time.Second
+
+// createOrUpdateUserKubeconfig generates the <cluster>-user-kubeconfig Secret, a non-admin kubeconfig sharing the
+// Kubernetes API Server endpoint and Certificate Authority with the admin one.
+//
+//nolint:funlen,cyclop
+func (r *StewardControlPlaneReconciler) createOrUpdateUserKubeconfig(ctx context.Context, reader client.Client, cluster capiv1beta1.Cluster, scp v1alpha2.StewardControlPlane, tcp *stewardv1alpha1.TenantControlPlane) error {
+	capiUserKubeconfig := &corev1.Secret{}
+	capiUserKubeconfig.Name = cluster.Name + "-user-kubeconfig"
+	capiUserKubeconfig.Namespace = cluster.Namespace
+
+	if scp.Spec.UserKubeconfig == nil {
+		return r.deleteUserKubeconfig(ctx, scp, capiUserKubeconfig)
+	}
+
+	stewardAdminKubeconfig := &corev1.Secret{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: tcp.Status.KubeConfig.Admin.SecretName, Namespace: tcp.Namespace}, stewardAdminKubeconfig); err != nil {
+		return errors.Wrap(err, "cannot retrieve source-of-truth for admin kubeconfig")
+	}
+
+	adminConfig, err := clientcmd.Load(stewardAdminKubeconfig.Data[kubeconfigSecretKey(scp)])
+	if err != nil {
+		return errors.Wrap(err, "cannot decode *stewardv1alpha1.TenantControlPlane admin kubeconfig")
+	}
+
+	adminContext, ok := adminConfig.Contexts[adminConfig.CurrentContext]
+	if !ok {
+		return errors.New("missing current context from *stewardv1alpha1.TenantControlPlane admin kubeconfig")
+	}
+
+	adminCluster, ok := adminConfig.Clusters[adminContext.Cluster]
+	if !ok {
+		return errors.New("missing cluster from *stewardv1alpha1.TenantControlPlane admin kubeconfig")
+	}
+	// Sharing the same server of the admin kubeconfig, including the rewritten one.
+	server, err := r.kubeconfigServer(scp, tcp)
+	if err != nil {
+		return err
+	}
+
+	if server != "" {
+		adminCluster.Server = server
+	}
+
+	stewardCA := &corev1.Secret{}
+	if err = reader.Get(ctx, types.NamespacedName{Name: tcp.Status.Certificates.CA.SecretName, Namespace: tcp.Namespace}, stewardCA); err != nil {
+		return errors.Wrap(err, "cannot retrieve source-of-truth as Certificate Authority")
+	}
+
+	checksum, err := userKubeconfigChecksum(*scp.Spec.UserKubeconfig, adminCluster, stewardCA.Data["ca.crt"])
+	if err != nil {
+		return err
+	}
+
+	issueCertificate := func() ([]byte, []byte, error) {
+		return newUserCertificate(*scp.Spec.UserKubeconfig, stewardCA)
+	}
+	// The certificate signing request whose certificate has been collected, deleted once stored.
+	var issuedRequest string
+
+	var clientset kubernetes.Interface
+	// Delegating the signature to the workload cluster, since the Certificate Authority private key is withheld:
+	// the certificate is collected by a later reconciliation, rather than waiting for the signer.
+	if isCertificateAuthorityKeyWithheld(scp) {
+		if clientset, err = workloadClientset(adminConfig); err != nil {
+			return err
+		}
+
+		issueCertificate = func() ([]byte, []byte, error) {
+			crt, key, issueErr := requestUserCertificate(ctx, clientset, capiUserKubeconfig, checksum, *scp.Spec.UserKubeconfig)
+			if issueErr == nil {
+				issuedRequest = userCertificateRequestName(checksum, key)
+			}
+
+			return crt, key, issueErr
+		}
+	}
+
+	var pendingErr error
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, scopeErr := controllerutil.CreateOrUpdate(ctx, r.client, capiUserKubeconfig, func() error {
+			pendingErr, issuedRequest = nil, ""
+
+			labels := capiUserKubeconfig.Labels
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			labels[capiv1beta1.ClusterNameLabel] = cluster.Name
+			labels["steward.butlerlabs.dev/component"] = "capi"
+			labels["steward.butlerlabs.dev/secret"] = "user-kubeconfig"
+			labels["steward.butlerlabs.dev/cluster"] = cluster.Name
+			labels["steward.butlerlabs.dev/tcp"] = tcp.Name
+
+			capiUserKubeconfig.SetLabels(labels)
+			// Only set Type on creation - Secret types are immutable
+			if capiUserKubeconfig.CreationTimestamp.IsZero() {
+				capiUserKubeconfig.Type = capiv1beta1.ClusterSecretType
+			}
+			// The kubeconfig is generated again only if the inputs changed, or the client certificate must be renewed.
+			if capiUserKubeconfig.Annotations[UserKubeconfigChecksumAnnotation] != checksum || userCertificateNeedsRenewal(capiUserKubeconfig.Data["value"]) {
+				value, genErr := generateUserKubeconfig(cluster.Name, *scp.Spec.UserKubeconfig, adminCluster, issueCertificate)
+
+				switch {
+				case errors.Is(genErr, ErrEnqueueBack):
+					// Persisting the private key of the pending request, keeping the previous kubeconfig meanwhile.
+					pendingErr = genErr
+				case genErr != nil:
+					return genErr
+				default:
+					if capiUserKubeconfig.Annotations == nil {
+						capiUserKubeconfig.Annotations = map[string]string{}
+					}
+
+					capiUserKubeconfig.Annotations[UserKubeconfigChecksumAnnotation] = checksum
+					capiUserKubeconfig.Data = map[string][]byte{
+						"value": value,
+					}
+				}
+			}
+
+			return controllerutil.SetControllerReference(&scp, capiUserKubeconfig, r.client.Scheme())
+		})
+
+		return scopeErr //nolint:wrapcheck
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot create or update user Kubeconfig secret")
+	}
+	// The collected certificate is stored, the request is no longer required.
+	if issuedRequest != "" {
+		if err = clientset.CertificatesV1().CertificateSigningRequests().Delete(ctx, issuedRequest, metav1.DeleteOptions{}); client.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, "cannot delete user certificate signing request")
+		}
+	}
+
+	return pendingErr
+}
+
+// deleteUserKubeconfig removes the user kubeconfig once disabled, only if generated by the StewardControlPlane.
+func (r *StewardControlPlaneReconciler) deleteUserKubeconfig(ctx context.Context, scp v1alpha2.StewardControlPlane, secret *corev1.Secret) error {
+	if err := r.client.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, secret); err != nil {
+		return errors.Wrap(client.IgnoreNotFound(err), "cannot retrieve user Kubeconfig secret")
+	}
+
+	if !metav1.IsControlledBy(secret, &scp) {
+		return nil
+	}
+
+	if err := r.client.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, "cannot delete user Kubeconfig secret")
+	}
+
+	return nil
+}
+
+func userKubeconfigChecksum(spec v1alpha2.UserKubeconfigSpec, adminCluster *clientcmdapi.Cluster, caCrt []byte) (string, error) {
+	data, err := json.Marshal(struct {
+		Spec   v1alpha2.UserKubeconfigSpec `json:"spec"`
+		Server string                      `json:"server"`
+		CA     []byte                      `json:"ca"`
+	}{Spec: spec, Server: adminCluster.Server, CA: caCrt})
+	if err != nil {
+		return "", errors.Wrap(err, "cannot compute user kubeconfig checksum")
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// userCertificateNeedsRenewal returns true when two thirds of the client certificate validity elapsed:
+// kubeconfig with no client certificate, such as the exec-based ones, don't need any renewal.
+func userCertificateNeedsRenewal(value []byte) bool {
+	if len(value) == 0 {
+		return true
+	}
+
+	config, err := clientcmd.Load(value)
+	if err != nil {
+		return true
+	}
+
+	for _, authInfo := range config.AuthInfos {
+		if len(authInfo.ClientCertificateData) == 0 {
+			continue
+		}
+
+		certs, certErr := cert.ParseCertsPEM(authInfo.ClientCertificateData)
+		if certErr != nil || len(certs) == 0 {
+			return true
+		}
+
+		validity := certs[0].NotAfter.Sub(certs[0].NotBefore)
+
+		return time.Now().After(certs[0].NotBefore.Add(validity * 2 / 3)) //nolint:mnd
+	}
+
+	return false
+}
+
+func generateUserKubeconfig(clusterName string, spec v1alpha2.UserKubeconfigSpec, adminCluster *clientcmdapi.Cluster, issueCertificate func() ([]byte, []byte, error)) ([]byte, error) {
+	authInfo := clientcmdapi.NewAuthInfo()
+
+	if exec := spec.Exec; exec != nil {
+		authInfo.Exec = &clientcmdapi.ExecConfig{
+			Command:            exec.Command,
+			Args:               exec.Args,
+			APIVersion:         exec.APIVersion,
+			InstallHint:        exec.InstallHint,
+			ProvideClusterInfo: exec.ProvideClusterInfo,
+			InteractiveMode:    clientcmdapi.ExecInteractiveMode(exec.InteractiveMode),
+		}
+
+		for _, env := range exec.Env {
+			authInfo.Exec.Env = append(authInfo.Exec.Env, clientcmdapi.ExecEnvVar{Name: env.Name, Value: env.Value})
+		}
+	} else {
+		crt, key, err := issueCertificate()
+		if err != nil {
+			return nil, err
+		}
+
+		authInfo.ClientCertificateData, authInfo.ClientKeyData = crt, key
+	}
+
+	contextName := fmt.Sprintf("%s@%s", spec.Username, clusterName)
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[clusterName] = adminCluster.DeepCopy()
+	config.AuthInfos[spec.Username] = authInfo
+	config.Contexts[contextName] = &clientcmdapi.Context{Cluster: clusterName, AuthInfo: spec.Username}
+	config.CurrentContext = contextName
+
+	value, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot encode user kubeconfig")
+	}
+
+	return value, nil
+}
+
+// newUserCertificate issues a client certificate for the user identity, signed by the TenantControlPlane Certificate Authority.
+func newUserCertificate(spec v1alpha2.UserKubeconfigSpec, stewardCA *corev1.Secret) ([]byte, []byte, error) {
+	caCerts, err := cert.ParseCertsPEM(stewardCA.Data["ca.crt"])
+	if err != nil || len(caCerts) == 0 {
+		return nil, nil, errors.New("missing Certificate value from *stewardv1alpha1.TenantControlPlane CA")
+	}
+
+	parsedKey, err := keyutil.ParsePrivateKeyPEM(stewardCA.Data["ca.key"])
+	if err != nil {
+		return nil, nil, errors.New("missing Private Key value from *stewardv1alpha1.TenantControlPlane CA")
+	}
+
+	caKey, ok := parsedKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, errors.New("unsupported Private Key from *stewardv1alpha1.TenantControlPlane CA")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot generate user private key")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128)) //nolint:mnd
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot generate certificate serial number")
+	}
+
+	// Tolerating clock skews between the management and the workload cluster.
+	now := time.Now().Add(-5 * time.Minute) //nolint:mnd
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: spec.Username, Organization: spec.Groups},
+		NotBefore:    now,
+		NotAfter:     now.Add(userCertificateValidity(spec)),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCerts[0], key.Public(), caKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot sign user certificate")
+	}
+
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot encode user private key")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: cert.CertificateBlockType, Bytes: der}), keyPEM, nil
+}
+
+// workloadClientset returns a client for the workload cluster, authenticated with the admin kubeconfig.
+func workloadClientset(adminConfig *clientcmdapi.Config) (kubernetes.Interface, error) {
+	restConfig, err := clientcmd.NewDefaultClientConfig(*adminConfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the workload cluster client configuration")
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the workload cluster client")
+	}
+
+	return clientset, nil
+}
+
+// requestUserCertificate issues a client certificate for the user identity with the kubernetes.io/kube-apiserver-client
+// signer of the workload cluster, scoped to the client certificates: the request is approved with the admin kubeconfig.
+// The private key is stored in the user kubeconfig Secret until the certificate is issued, returning ErrEnqueueBack meanwhile:
+// the request name is derived from the private key and the kubeconfig checksum, retrieving it at the next reconciliation.
+//
+//nolint:cyclop
+func requestUserCertificate(ctx context.Context, clientset kubernetes.Interface, secret *corev1.Secret, checksum string, spec v1alpha2.UserKubeconfigSpec) ([]byte, []byte, error) {
+	keyPEM := secret.Data[userCertificateRequestKey]
+
+	parsedKey, err := keyutil.ParsePrivateKeyPEM(keyPEM)
+	if err != nil {
+		key, keyErr := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if keyErr != nil {
+			return nil, nil, errors.Wrap(keyErr, "cannot generate user private key")
+		}
+
+		if keyPEM, keyErr = keyutil.MarshalPrivateKeyToPEM(key); keyErr != nil {
+			return nil, nil, errors.Wrap(keyErr, "cannot encode user private key")
+		}
+
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+
+		secret.Data[userCertificateRequestKey], parsedKey = keyPEM, key
+	}
+
+	key, ok := parsedKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, errors.New("unsupported user private key")
+	}
+
+	name := userCertificateRequestName(checksum, keyPEM)
+
+	request, err := clientset.CertificatesV1().CertificateSigningRequests().Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		csrPEM, csrErr := cert.MakeCSR(key, &pkix.Name{CommonName: spec.Username, Organization: spec.Groups}, nil, nil)
+		if csrErr != nil {
+			return nil, nil, errors.Wrap(csrErr, "cannot generate user certificate signing request")
+		}
+
+		validity := userCertificateValidity(spec)
+		usages := []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth}
+
+		if _, _, err = csr.RequestCertificateWithContext(ctx, clientset, csrPEM, name, certificatesv1.KubeAPIServerClientSignerName, &validity, usages, key); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot request user certificate")
+		}
+
+		request, err = clientset.CertificatesV1().CertificateSigningRequests().Get(ctx, name, metav1.GetOptions{})
+	}
+
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot retrieve user certificate signing request")
+	}
+
+	approved := false
+
+	for _, condition := range request.Status.Conditions {
+		switch condition.Type {
+		case certificatesv1.CertificateApproved:
+			approved = true
+		case certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+			// Deleting the request, created again at the next reconciliation.
+			if err = clientset.CertificatesV1().CertificateSigningRequests().Delete(ctx, name, metav1.DeleteOptions{}); client.IgnoreNotFound(err) != nil {
+				return nil, nil, errors.Wrap(err, "cannot delete user certificate signing request")
+			}
+
+			return nil, nil, errors.Errorf("user certificate signing request %s %s: %s", name, condition.Type, condition.Message)
+		default:
+		}
+	}
+
+	if !approved {
+		request.Status.Conditions = append(request.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+			Type:    certificatesv1.CertificateApproved,
+			Status:  corev1.ConditionTrue,
+			Reason:  "StewardControlPlaneApproved",
+			Message: "User kubeconfig certificate requested by the StewardControlPlane",
+		})
+
+		if _, err = clientset.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, name, request, metav1.UpdateOptions{}); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot approve user certificate signing request")
+		}
+	}
+
+	if len(request.Status.Certificate) == 0 {
+		return nil, nil, fmt.Errorf("user certificate %s not yet issued by the workload cluster signer, %w", name, ErrEnqueueBack)
+	}
+
+	if _, err = tls.X509KeyPair(request.Status.Certificate, keyPEM); err != nil {
+		return nil, nil, errors.Wrap(err, "the issued user certificate doesn't match the private key")
+	}
+
+	return request.Status.Certificate, keyPEM, nil
+}
+
+func userCertificateValidity(spec v1alpha2.UserKubeconfigSpec) time.Duration {
+	if spec.CertificateValidity != nil {
+		return spec.CertificateValidity.Duration
+	}
+
+	return 365 * 24 * time.Hour //nolint:mnd
+}
+
+// userCertificateRequestName returns the name of the certificate signing request for the given kubeconfig checksum and private key.
+func userCertificateRequestName(checksum string, keyPEM []byte) string {
+	sum := sha256.Sum256(append([]byte(checksum), keyPEM...))
+
+	return "steward-user-kubeconfig-" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega" //nolint:revive
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/cert"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+// signCertificateRequest issues the certificate of the request, as the workload cluster signer does.
+func signCertificateRequest(g *WithT, request *certificatesv1.CertificateSigningRequest) []byte {
+	block, _ := pem.Decode(request.Spec.Request)
+	g.Expect(block).NotTo(BeNil())
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      csr.Subject,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, &x509.Certificate{Subject: pkix.Name{CommonName: "signer"}}, csr.PublicKey, caKey)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: cert.CertificateBlockType, Bytes: der})
+}
+
+func TestRequestUserCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	clientset := fake.NewClientset()
+	secret := &corev1.Secret{}
+	spec := v1alpha2.UserKubeconfigSpec{Username: "developer", Groups: []string{"developers"}}
+	// The request is submitted and approved, storing the private key rather than waiting for the signer.
+	_, _, err := requestUserCertificate(ctx, clientset, secret, "checksum", spec)
+	g.Expect(err).To(MatchError(ErrEnqueueBack))
+	g.Expect(secret.Data).To(HaveKey(userCertificateRequestKey))
+
+	requests, err := clientset.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Items).To(HaveLen(1))
+
+	request := requests.Items[0]
+	// The request is named after the stored private key, deleted once the certificate is collected.
+	g.Expect(request.Name).To(Equal(userCertificateRequestName("checksum", secret.Data[userCertificateRequestKey])))
+	g.Expect(request.Spec.SignerName).To(Equal(certificatesv1.KubeAPIServerClientSignerName))
+	g.Expect(request.Status.Conditions).To(ContainElement(HaveField("Type", certificatesv1.CertificateApproved)))
+	// The pending request is retrieved, rather than submitting a new one.
+	_, _, err = requestUserCertificate(ctx, clientset, secret, "checksum", spec)
+	g.Expect(err).To(MatchError(ErrEnqueueBack))
+
+	requests, err = clientset.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Items).To(HaveLen(1))
+	// The certificate is collected once issued.
+	request.Status.Certificate = signCertificateRequest(g, &request)
+
+	_, err = clientset.CertificatesV1().CertificateSigningRequests().UpdateStatus(ctx, &request, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	crt, key, err := requestUserCertificate(ctx, clientset, secret, "checksum", spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(crt).To(Equal(request.Status.Certificate))
+	g.Expect(key).To(Equal(secret.Data[userCertificateRequestKey]))
+	// A denied request is deleted, submitted again at the next attempt.
+	request.Status.Conditions = append(request.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:   certificatesv1.CertificateDenied,
+		Status: corev1.ConditionTrue,
+	})
+
+	_, err = clientset.CertificatesV1().CertificateSigningRequests().UpdateStatus(ctx, &request, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, _, err = requestUserCertificate(ctx, clientset, secret, "checksum", spec)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err).NotTo(MatchError(ErrEnqueueBack))
+
+	requests, err = clientset.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Items).To(BeEmpty())
+}
+
+func TestWorkerJoinPlaceholderKey(t *testing.T) {
+	g := NewWithT(t)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	caCrt, err := cert.NewSelfSignedCACert(cert.Config{CommonName: "kubernetes"}, caKey)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	caCrtPEM := pem.EncodeToMemory(&pem.Block{Type: cert.CertificateBlockType, Bytes: caCrt.Raw})
+
+	caKeyDER, err := x509.MarshalECPrivateKey(caKey)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	caKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: caKeyDER})
+	// The Certificate Authority private key replicated before withholding it is replaced.
+	placeholder, err := workerJoinPlaceholderKey(caKeyPEM, caCrtPEM)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(placeholder).NotTo(BeEmpty())
+	g.Expect(placeholder).NotTo(Equal(caKeyPEM))
+	// The placeholder key is kept across the reconciliations.
+	g.Expect(workerJoinPlaceholderKey(placeholder, caCrtPEM)).To(Equal(placeholder))
+	// A missing or invalid key is generated.
+	g.Expect(workerJoinPlaceholderKey(nil, caCrtPEM)).NotTo(BeEmpty())
+	g.Expect(workerJoinPlaceholderKey([]byte("invalid"), caCrtPEM)).NotTo(Equal([]byte("invalid")))
+}
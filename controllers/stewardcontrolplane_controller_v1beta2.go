@@ -0,0 +1,104 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+// setContractConditions derives the conditions required by the Cluster API v1beta2 control plane contract
+// from the TenantControlPlane: a nil value means it has not been reconciled yet.
+func setContractConditions(scp *scpv1alpha2.StewardControlPlane, cluster *capiv1beta1.Cluster, tcp *stewardv1alpha1.TenantControlPlane) {
+	var versionStatus stewardv1alpha1.KubernetesVersionStatus
+
+	var deployment stewardv1alpha1.KubernetesDeploymentStatus
+
+	if tcp != nil {
+		deployment = tcp.Status.Kubernetes.Deployment
+
+		if tcp.Status.Kubernetes.Version.Status != nil {
+			versionStatus = *tcp.Status.Kubernetes.Version.Status
+		}
+	}
+
+	// Available
+	available := metav1.Condition{Status: metav1.ConditionFalse, Reason: capiv1beta1.NotAvailableV1Beta2Reason}
+
+	switch {
+	case tcp == nil || versionStatus == "":
+		available.Message = "TenantControlPlane is not yet reconciled by Steward"
+	case (versionStatus == stewardv1alpha1.VersionReady || versionStatus == stewardv1alpha1.VersionUpgrading) && deployment.AvailableReplicas > 0:
+		available.Status, available.Reason = metav1.ConditionTrue, capiv1beta1.AvailableV1Beta2Reason
+	default:
+		available.Message = fmt.Sprintf("TenantControlPlane in %s status with %d available replicas", versionStatus, deployment.AvailableReplicas)
+	}
+
+	setContractCondition(scp, scpv1alpha2.AvailableConditionType, available)
+	// RollingOut
+	rollingOut := metav1.Condition{Status: metav1.ConditionFalse, Reason: capiv1beta1.NotRollingOutV1Beta2Reason}
+
+	switch {
+	case versionStatus == stewardv1alpha1.VersionUpgrading:
+		rollingOut.Status, rollingOut.Reason = metav1.ConditionTrue, capiv1beta1.RollingOutV1Beta2Reason
+		rollingOut.Message = fmt.Sprintf("Upgrading Kubernetes version from %s to %s", tcp.Status.Kubernetes.Version.Version, tcp.Spec.Kubernetes.Version)
+	case deployment.UpdatedReplicas < deployment.Replicas:
+		rollingOut.Status, rollingOut.Reason = metav1.ConditionTrue, capiv1beta1.RollingOutV1Beta2Reason
+		rollingOut.Message = fmt.Sprintf("%d out of %d replicas are not up-to-date", deployment.Replicas-deployment.UpdatedReplicas, deployment.Replicas)
+	}
+
+	setContractCondition(scp, scpv1alpha2.RollingOutConditionType, rollingOut)
+	// ScalingUp and ScalingDown
+	desired := ptr.Deref(scp.Spec.Replicas, 0)
+
+	scalingUp := metav1.Condition{Status: metav1.ConditionFalse, Reason: capiv1beta1.NotScalingUpV1Beta2Reason}
+	scalingDown := metav1.Condition{Status: metav1.ConditionFalse, Reason: capiv1beta1.NotScalingDownV1Beta2Reason}
+
+	switch {
+	case tcp != nil && desired > deployment.Replicas:
+		scalingUp.Status, scalingUp.Reason = metav1.ConditionTrue, capiv1beta1.ScalingUpV1Beta2Reason
+		scalingUp.Message = fmt.Sprintf("Scaling up from %d to %d replicas", deployment.Replicas, desired)
+	case tcp != nil && desired < deployment.Replicas:
+		scalingDown.Status, scalingDown.Reason = metav1.ConditionTrue, capiv1beta1.ScalingDownV1Beta2Reason
+		scalingDown.Message = fmt.Sprintf("Scaling down from %d to %d replicas", deployment.Replicas, desired)
+	}
+
+	setContractCondition(scp, scpv1alpha2.ScalingUpConditionType, scalingUp)
+	setContractCondition(scp, scpv1alpha2.ScalingDownConditionType, scalingDown)
+	// Deleting, keeping the deletion progress reported by the finalizer handling.
+	deleting := metav1.Condition{Status: metav1.ConditionFalse, Reason: capiv1beta1.NotDeletingV1Beta2Reason}
+
+	if scp.DeletionTimestamp != nil {
+		deleting.Status, deleting.Reason = metav1.ConditionTrue, capiv1beta1.DeletingV1Beta2Reason
+
+		if current := meta.FindStatusCondition(scp.Status.Conditions, string(scpv1alpha2.DeletingConditionType)); current != nil && current.Status == metav1.ConditionTrue {
+			deleting.Message = current.Message
+		}
+	}
+
+	setContractCondition(scp, scpv1alpha2.DeletingConditionType, deleting)
+	// Paused
+	paused := metav1.Condition{Status: metav1.ConditionFalse, Reason: capiv1beta1.NotPausedV1Beta2Reason}
+
+	if cluster != nil && annotations.IsPaused(cluster, scp) {
+		paused.Status, paused.Reason = metav1.ConditionTrue, capiv1beta1.PausedV1Beta2Reason
+	}
+
+	setContractCondition(scp, scpv1alpha2.PausedConditionType, paused)
+}
+
+func setContractCondition(scp *scpv1alpha2.StewardControlPlane, conditionType scpv1alpha2.StewardControlPlaneConditionType, condition metav1.Condition) {
+	condition.Type = string(conditionType)
+	condition.ObservedGeneration = scp.Generation
+
+	meta.SetStatusCondition(&scp.Status.Conditions, condition)
+}
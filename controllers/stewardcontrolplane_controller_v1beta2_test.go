@@ -0,0 +1,72 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega" //nolint:revive
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+func TestSetContractConditionsDeletingAndPaused(t *testing.T) {
+	now := metav1.Now()
+
+	tests := []struct {
+		name     string
+		deleted  bool
+		paused   bool
+		previous *metav1.Condition
+		deleting metav1.ConditionStatus
+		message  string
+		pause    metav1.ConditionStatus
+	}{
+		{name: "running", deleting: metav1.ConditionFalse, pause: metav1.ConditionFalse},
+		{name: "paused Cluster", paused: true, deleting: metav1.ConditionFalse, pause: metav1.ConditionTrue},
+		{name: "deleting", deleted: true, deleting: metav1.ConditionTrue, pause: metav1.ConditionFalse},
+		{
+			name:     "deleting with the reported progress",
+			deleted:  true,
+			previous: &metav1.Condition{Status: metav1.ConditionTrue, Message: "waiting for the deletion of the remote TenantControlPlanes"},
+			deleting: metav1.ConditionTrue,
+			message:  "waiting for the deletion of the remote TenantControlPlanes",
+			pause:    metav1.ConditionFalse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scp := &scpv1alpha2.StewardControlPlane{}
+			if tt.deleted {
+				scp.DeletionTimestamp = &now
+			}
+
+			if tt.previous != nil {
+				previous := *tt.previous
+				previous.Type, previous.Reason = string(scpv1alpha2.DeletingConditionType), capiv1beta1.DeletingV1Beta2Reason
+				scp.Status.Conditions = append(scp.Status.Conditions, previous)
+			}
+
+			cluster := &capiv1beta1.Cluster{}
+			cluster.Spec.Paused = tt.paused
+
+			setContractConditions(scp, cluster, nil)
+
+			deleting := meta.FindStatusCondition(scp.Status.Conditions, string(scpv1alpha2.DeletingConditionType))
+			g.Expect(deleting).NotTo(BeNil())
+			g.Expect(deleting.Status).To(Equal(tt.deleting))
+			g.Expect(deleting.Message).To(Equal(tt.message))
+
+			paused := meta.FindStatusCondition(scp.Status.Conditions, string(scpv1alpha2.PausedConditionType))
+			g.Expect(paused).NotTo(BeNil())
+			g.Expect(paused.Status).To(Equal(tt.pause))
+		})
+	}
+}
@@ -5,19 +5,28 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 
-	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
-	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	ecr "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
 )
 
-func (r *StewardControlPlaneReconciler) handleFinalizer(ctx context.Context, scp *v1alpha1.StewardControlPlane) error {
+func (r *StewardControlPlaneReconciler) handleFinalizer(ctx context.Context, scp *v1alpha2.StewardControlPlane) error {
 	finalizers := sets.New[string](scp.Finalizers...)
 	if !finalizers.Has(ExternalClusterReferenceFinalizer) {
 		err := retry.RetryOnConflict(retry.DefaultRetry, func() (scopedErr error) { //nolint:nonamedreturns
@@ -39,53 +48,208 @@ func (r *StewardControlPlaneReconciler) handleFinalizer(ctx context.Context, scp
 	return nil
 }
 
-func (r *StewardControlPlaneReconciler) handleDeletion(ctx context.Context, scp v1alpha1.StewardControlPlane) error {
+// handleDeletion waits for the TenantControlPlanes deployed to the external clusters to be gone, before removing the finalizer:
+// the remote resources are orphaned when forced with the annotation, or once the deletion timeout expired,
+// such as when the external cluster is no longer reachable.
+func (r *StewardControlPlaneReconciler) handleDeletion(ctx context.Context, scp v1alpha2.StewardControlPlane) (ctrl.Result, error) {
 	finalizers, log := sets.New[string](scp.Finalizers...), ctrllog.FromContext(ctx)
 
-	if !finalizers.Has(ExternalClusterReferenceFinalizer) || scp.Spec.Deployment.ExternalClusterReference == nil {
+	if !finalizers.Has(ExternalClusterReferenceFinalizer) {
 		log.Info("waiting for StewardControlPlane finalizers")
 
-		return nil
+		return ctrl.Result{}, r.reportDeleting(ctx, &scp, "")
+	}
+
+	var pending []string
+
+	var deletionErr error
+	// Deleting the source TenantControlPlane of an in-progress migration from an external cluster,
+	// or the one reported as hosted by a different cluster, such as when the ExternalClusterReference has been changed.
+	var previous *v1alpha2.HostingStatus
+
+	switch {
+	case scp.Status.Migration != nil:
+		previous = &scp.Status.Migration.Source
+	case scp.Status.Hosting != nil && !isSameHosting(scp.Namespace, scp.Status.Hosting.ExternalClusterReference, scp.Spec.Deployment.ExternalClusterReference):
+		previous = scp.Status.Hosting
+	}
+
+	if previous != nil && previous.ExternalClusterReference != nil {
+		key := types.NamespacedName{Name: previous.TenantControlPlaneName, Namespace: previous.TenantControlPlaneNamespace}
+
+		if name, err := r.deleteRemoteTenantControlPlane(ctx, scp, previous.ExternalClusterReference, &key); name != "" {
+			pending, deletionErr = append(pending, name), err
+		}
+	}
+
+	if ref := scp.Spec.Deployment.ExternalClusterReference; ref != nil {
+		if name, err := r.deleteRemoteTenantControlPlane(ctx, scp, ref, nil); name != "" {
+			pending = append(pending, name)
+
+			if err != nil {
+				deletionErr = err
+			}
+		}
+	}
+
+	if len(pending) == 0 {
+		log.Info("remote TenantControlPlane has been deleted")
+
+		if ref := scp.Spec.Deployment.ExternalClusterReference; ref != nil && ref.ManagedNamespace != nil {
+			if nsErr := r.deleteManagedNamespace(ctx, scp); nsErr != nil {
+				// The hosting cluster could be unreachable, or the Namespace permissions revoked.
+				if reason := r.forceDeletionReason(scp); reason != "" {
+					message := fmt.Sprintf("deletion forced %s, orphaning the deployment Namespace %s: %s", reason, ref.DeploymentNamespace, nsErr.Error())
+
+					log.Info(message)
+					r.recorder.Event(&scp, corev1.EventTypeWarning, "RemoteResourcesOrphaned", message)
+
+					return ctrl.Result{}, r.removeExternalClusterReferenceFinalizer(ctx, &scp)
+				}
+
+				log.Error(nsErr, "cannot delete the deployment Namespace")
+
+				return ctrl.Result{}, nsErr
+			}
+		}
+
+		return ctrl.Result{}, r.removeExternalClusterReferenceFinalizer(ctx, &scp)
+	}
+
+	if reason := r.forceDeletionReason(scp); reason != "" {
+		message := fmt.Sprintf("deletion forced %s, orphaning the remote TenantControlPlanes: %s", reason, strings.Join(pending, ", "))
+
+		log.Info(message)
+		r.recorder.Event(&scp, corev1.EventTypeWarning, "RemoteResourcesOrphaned", message)
+
+		return ctrl.Result{}, r.removeExternalClusterReferenceFinalizer(ctx, &scp)
+	}
+
+	message := "waiting for the deletion of the remote TenantControlPlanes: " + strings.Join(pending, ", ")
+	if deletionErr != nil {
+		message += ", " + deletionErr.Error()
+	}
+
+	if err := r.reportDeleting(ctx, &scp, message); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if deletionErr != nil {
+		log.Error(deletionErr, "cannot delete remote TenantControlPlane")
+
+		return ctrl.Result{}, deletionErr
 	}
 
-	remoteClient, cErr := r.extractRemoteClient(ctx, scp)
-	if cErr != nil {
-		log.Error(cErr, "cannot generate remote client for deletion")
+	log.Info(message)
 
-		return cErr
+	return ctrl.Result{RequeueAfter: r.deletionRetryAfter(scp)}, nil
+}
+
+// deleteRemoteTenantControlPlane deletes the TenantControlPlane deployed to the given external cluster,
+// returning its name until it's gone, such as while Steward performs the DataStore clean-up.
+func (r *StewardControlPlaneReconciler) deleteRemoteTenantControlPlane(ctx context.Context, scp v1alpha2.StewardControlPlane, ref *v1alpha2.ExternalClusterReference, key *types.NamespacedName) (string, error) {
+	name := ecr.GenerateKeyName(scp.Namespace, ref)
+
+	remoteClient, err := r.remoteClientFor(ctx, scp, ref)
+	if err != nil {
+		return name, errors.Wrap(err, "cannot generate remote client for deletion")
+	}
+
+	if key == nil {
+		tcpKey, keyErr := remoteTenantControlPlaneKey(ctx, remoteClient, scp)
+		if keyErr != nil {
+			return name, keyErr
+		}
+
+		key = &tcpKey
 	}
 
 	var tcp stewardv1alpha1.TenantControlPlane
-	tcp.Name, tcp.Namespace = externalclusterreference.GenerateRemoteTenantControlPlaneNames(scp)
+	if err = remoteClient.Get(ctx, *key, &tcp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
 
-	if tcpErr := remoteClient.Delete(ctx, &tcp); tcpErr != nil {
-		if errors.IsNotFound(tcpErr) {
-			log.Info("remote TenantControlPlane is already deleted")
+		return name, errors.Wrap(err, "cannot retrieve remote TenantControlPlane")
+	}
 
-			return nil
+	name = key.Namespace + "/" + key.Name
+
+	if tcp.DeletionTimestamp.IsZero() {
+		if err = remoteClient.Delete(ctx, &tcp); client.IgnoreNotFound(err) != nil {
+			return name, errors.Wrap(err, "cannot delete remote TenantControlPlane")
 		}
+	}
 
-		log.Error(tcpErr, "cannot delete remote TenantControlPlane")
+	return name, nil
+}
 
-		return tcpErr //nolint:wrapcheck
+// deleteManagedNamespace deletes the deployment Namespace managed by the provider, once the remote TenantControlPlane is gone.
+func (r *StewardControlPlaneReconciler) deleteManagedNamespace(ctx context.Context, scp v1alpha2.StewardControlPlane) error {
+	remoteClient, err := r.extractRemoteClient(ctx, scp)
+	if err != nil {
+		return errors.Wrap(err, "cannot generate remote client for deletion")
 	}
 
-	log.Info("remote TenantControlPlane has been deleted")
+	return r.deleteDeploymentNamespace(ctx, remoteClient, scp)
+}
+
+// forceDeletionReason returns why the remote resources must be orphaned, empty if they must be deleted.
+func (r *StewardControlPlaneReconciler) forceDeletionReason(scp v1alpha2.StewardControlPlane) string {
+	if scp.Annotations[v1alpha2.ForceDeleteAnnotation] == "true" {
+		return "with the " + v1alpha2.ForceDeleteAnnotation + " annotation"
+	}
+
+	if timeout := r.ExternalClusterReferenceDeletionTimeout; timeout > 0 && scp.DeletionTimestamp != nil && time.Since(scp.DeletionTimestamp.Time) > timeout {
+		return "since the deletion timeout of " + timeout.String() + " expired"
+	}
+
+	return ""
+}
+
+// deletionRetryAfter returns the delay to check the remote TenantControlPlanes deletion, bounded by the deletion timeout.
+func (r *StewardControlPlaneReconciler) deletionRetryAfter(scp v1alpha2.StewardControlPlane) time.Duration {
+	delay := r.backoff.When(types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace})
+
+	if timeout := r.ExternalClusterReferenceDeletionTimeout; timeout > 0 && scp.DeletionTimestamp != nil {
+		if remaining := time.Until(scp.DeletionTimestamp.Add(timeout)); remaining > 0 && remaining < delay {
+			delay = remaining
+		}
+	}
+
+	return delay
+}
+
+// reportDeleting reports the StewardControlPlane as deleting, as required by the v1beta2 contract, along with the progress.
+func (r *StewardControlPlaneReconciler) reportDeleting(ctx context.Context, scp *v1alpha2.StewardControlPlane, message string) error {
+	if err := r.updateStewardControlPlaneStatus(ctx, scp, func() {
+		setContractCondition(scp, v1alpha2.DeletingConditionType, metav1.Condition{Status: metav1.ConditionTrue, Reason: capiv1beta1.DeletingV1Beta2Reason, Message: message})
+	}); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "unable to report scpv1alpha2.StewardControlPlane as deleting")
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *StewardControlPlaneReconciler) removeExternalClusterReferenceFinalizer(ctx context.Context, scp *v1alpha2.StewardControlPlane) error {
+	log := ctrllog.FromContext(ctx)
 
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		if err := r.client.Get(ctx, types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace}, &scp); err != nil {
+		if err := r.client.Get(ctx, types.NamespacedName{Name: scp.Name, Namespace: scp.Namespace}, scp); err != nil {
 			return err //nolint:wrapcheck
 		}
 
-		finalizers = sets.New[string](scp.Finalizers...)
+		finalizers := sets.New[string](scp.Finalizers...)
 		finalizers.Delete(ExternalClusterReferenceFinalizer)
 
 		scp.Finalizers = finalizers.UnsortedList()
 
-		return r.client.Update(ctx, &scp)
+		return r.client.Update(ctx, scp)
 	})
 	if err != nil {
-		if errors.IsNotFound(err) {
+		if apierrors.IsNotFound(err) {
 			log.Info("object may have been deleted")
 
 			return nil
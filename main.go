@@ -6,10 +6,12 @@ package main
 import (
 	"flag"
 	"os"
+	"time"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -18,19 +20,24 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/component-base/featuregate"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controllers/crdmigrator"
+	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/util/flags"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	controlplanev1alpha1 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	controlplanev1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/controllers"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/features"
 	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/indexers"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/runtimehooks"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/webhooks"
 )
 
 var (
@@ -42,16 +49,26 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(stewardv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(capiv1beta1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	utilruntime.Must(runtimev1.AddToScheme(scheme))
 
 	utilruntime.Must(controlplanev1alpha1.AddToScheme(scheme))
+	utilruntime.Must(controlplanev1alpha2.AddToScheme(scheme))
 }
 
+// Required by the CRD migrator to move the stored objects to the storage version.
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions;customresourcedefinitions/status,verbs=update;patch,resourceNames=stewardcontrolplanes.controlplane.cluster.x-k8s.io;stewardcontrolplanetemplates.controlplane.cluster.x-k8s.io
+//+kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=stewardcontrolplanetemplates,verbs=get;list;watch;update;patch
+
 //nolint:funlen,cyclop
 func main() {
-	var dynamicInfraClusters []string
+	var dynamicInfraClusters, skipCRDMigrationPhases []string
 
 	metricsAddr, enableLeaderElection, probeAddr, maxConcurrentReconciles, managerOpts := "", false, "", 1, flags.ManagerOptions{}
 
+	var requeueMinDelay, requeueMaxDelay, certificatesExpiryThreshold, externalClusterReferenceDeletionTimeout time.Duration
+
 	flagSet := pflag.CommandLine
 
 	flagSet.StringSliceVar(&dynamicInfraClusters, "dynamic-infrastructure-clusters", nil, "When the DynamicInfrastructureClusterPatch feature flag is enabled, "+
@@ -63,6 +80,16 @@ func main() {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 	flagSet.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1, "The maximum number of concurrent StewardControlPlane reconciles which can be run")
+	flagSet.DurationVar(&requeueMinDelay, "requeue-min-delay", time.Second, "The initial delay of the per-object exponential backoff, "+
+		"used to enqueue back a StewardControlPlane waiting for changes, or failing its reconciliation.")
+	flagSet.DurationVar(&requeueMaxDelay, "requeue-max-delay", 5*time.Minute, "The maximum delay of the per-object exponential backoff, "+ //nolint:mnd
+		"used to enqueue back a StewardControlPlane waiting for changes, or failing its reconciliation.")
+	flagSet.DurationVar(&certificatesExpiryThreshold, "certificates-expiry-threshold", 30*24*time.Hour, "The duration before the expiration "+ //nolint:mnd
+		"when the TenantControlPlane certificates are reported as expiring.")
+	flagSet.DurationVar(&externalClusterReferenceDeletionTimeout, "external-cluster-reference-deletion-timeout", 0, "The duration after which "+
+		"a deleted StewardControlPlane stops waiting for the deletion of the remote TenantControlPlanes, orphaning them: zero means waiting indefinitely.")
+	flagSet.StringSliceVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", nil, "List of CRD migration phases to skip, "+
+		"valid values are: StorageVersionMigration, CleanupManagedFields.")
 	// zap logging FlagSet
 	var goFlagSet flag.FlagSet
 
@@ -97,6 +124,11 @@ func main() {
 			LockToDefault: false,
 			PreRelease:    featuregate.Alpha,
 		},
+		features.RuntimeSDK: {
+			Default:       false,
+			LockToDefault: false,
+			PreRelease:    featuregate.Alpha,
+		},
 	}); err != nil {
 		setupLog.Error(err, "unable to add feature gates")
 		os.Exit(1)
@@ -109,6 +141,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if requeueMinDelay <= 0 || requeueMaxDelay < requeueMinDelay {
+		setupLog.Error(errors.New("the requeue minimum delay must be positive, and not greater than the maximum one"), "invalid requeue delays")
+		os.Exit(1)
+	}
+
 	_, metricsOpts, err := flags.GetManagerOptions(managerOpts)
 	if err != nil {
 		setupLog.Error(err, "Unable to start manager: invalid flags")
@@ -142,17 +179,62 @@ func main() {
 		os.Exit(1)
 	}
 
-	ecrStore, triggerChannel := externalclusterreference.NewStore(), make(chan event.GenericEvent)
+	// Migrating the objects stored with a previous API version to the storage one, such as v1alpha1 to v1alpha2.
+	crdMigratorSkipPhases := make([]crdmigrator.Phase, 0, len(skipCRDMigrationPhases))
+	for _, phase := range skipCRDMigrationPhases {
+		crdMigratorSkipPhases = append(crdMigratorSkipPhases, crdmigrator.Phase(phase))
+	}
+
+	if err = (&crdmigrator.CRDMigrator{
+		Client:                 mgr.GetClient(),
+		APIReader:              mgr.GetAPIReader(),
+		SkipCRDMigrationPhases: crdMigratorSkipPhases,
+		Config: map[client.Object]crdmigrator.ByObjectConfig{
+			&controlplanev1alpha2.StewardControlPlane{}:         {UseCache: true, UseStatusForStorageVersionMigration: true},
+			&controlplanev1alpha2.StewardControlPlaneTemplate{}: {UseCache: false},
+		},
+	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: 1}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "CRDMigrator")
+		os.Exit(1)
+	}
+
+	ecrStore, trigger := externalclusterreference.NewStore(), externalclusterreference.NewTrigger()
+
+	var runtimeClient *runtimehooks.Client
+
+	if featureGate.Enabled(features.RuntimeSDK) {
+		if runtimeClient, err = runtimehooks.NewClient(mgr.GetClient(), mgr.GetAPIReader()); err != nil {
+			setupLog.Error(err, "unable to create Runtime SDK client")
+			os.Exit(1)
+		}
+	}
 
 	if err = (&controllers.StewardControlPlaneReconciler{
-		ExternalClusterReferenceStore: ecrStore,
-		FeatureGates:                  featureGate,
-		MaxConcurrentReconciles:       maxConcurrentReconciles,
-		DynamicInfrastructureClusters: sets.New[string](dynamicInfraClusters...),
-	}).SetupWithManager(ctx, mgr, triggerChannel); err != nil {
+		ExternalClusterReferenceStore:           ecrStore,
+		FeatureGates:                            featureGate,
+		MaxConcurrentReconciles:                 maxConcurrentReconciles,
+		DynamicInfrastructureClusters:           sets.New[string](dynamicInfraClusters...),
+		RequeueMinDelay:                         requeueMinDelay,
+		RequeueMaxDelay:                         requeueMaxDelay,
+		CertificatesExpiryThreshold:             certificatesExpiryThreshold,
+		RuntimeClient:                           runtimeClient,
+		ExternalClusterReferenceDeletionTimeout: externalClusterReferenceDeletionTimeout,
+	}).SetupWithManager(ctx, mgr, trigger); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "StewardControlPlane")
 		os.Exit(1)
 	}
+	// Webhooks can be disabled when running the manager locally with no certificates available.
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = (&webhooks.StewardControlPlane{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StewardControlPlane")
+			os.Exit(1)
+		}
+
+		if err = (&webhooks.StewardControlPlaneTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "StewardControlPlaneTemplate")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if featureGate.Enabled(features.ExternalClusterReference) || featureGate.Enabled(features.ExternalClusterReferenceCrossNamespace) {
@@ -161,7 +243,7 @@ func main() {
 			os.Exit(1)
 		}
 
-		if err = (&controllers.ExternalClusterReferenceReconciler{Client: mgr.GetClient(), Store: ecrStore, TriggerChannel: triggerChannel}).SetupWithManager(mgr); err != nil {
+		if err = (&controllers.ExternalClusterReferenceReconciler{Client: mgr.GetClient(), Store: ecrStore, Trigger: trigger}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "ExternalClusterReference")
 			os.Exit(1)
 		}
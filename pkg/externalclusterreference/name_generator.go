@@ -4,28 +4,150 @@
 package externalclusterreference
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
 	"strings"
+	"text/template"
 
 	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 
-	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 )
 
 const (
 	RemoteTCPPrefix = "kcp-"
+	// hashLength is the length of the hash suffix of the generated names, such as with the NamespaceName strategy.
+	hashLength = 8
+	// StewardControlPlaneNameLabel and StewardControlPlaneNamespaceLabel are the stable labels identifying the StewardControlPlane
+	// a remote TenantControlPlane belongs to, used to adopt it regardless of its name.
+	StewardControlPlaneNameLabel      = "ecr.steward.butlerlabs.dev/stewardcontrolplane-name"
+	StewardControlPlaneNamespaceLabel = "ecr.steward.butlerlabs.dev/stewardcontrolplane-namespace"
+	// StewardControlPlaneNameAnnotation keeps the full StewardControlPlane name, since the label value is hashed when exceeding its maximum length.
+	StewardControlPlaneNameAnnotation = "ecr.steward.butlerlabs.dev/stewardcontrolplane-name"
 )
 
-func ParseStewardControlPlaneUIDFromTenantControlPlane(tcp stewardv1alpha1.TenantControlPlane) string {
-	if !strings.HasPrefix(tcp.Name, RemoteTCPPrefix) {
-		return ""
+// StewardControlPlaneLabels returns the labels identifying the remote TenantControlPlane of the given StewardControlPlane:
+// the names longer than a label value are truncated, suffixed with their hash.
+func StewardControlPlaneLabels(kcp v1alpha2.StewardControlPlane) map[string]string {
+	name := kcp.Name
+	if len(name) > validation.LabelValueMaxLength {
+		name = hashedName(name, name, validation.LabelValueMaxLength)
 	}
 
-	return strings.TrimPrefix(tcp.Name, RemoteTCPPrefix)
+	return map[string]string{
+		StewardControlPlaneNameLabel:      name,
+		StewardControlPlaneNamespaceLabel: kcp.Namespace,
+	}
+}
+
+// StewardControlPlaneAnnotations returns the annotations of the remote TenantControlPlane of the given StewardControlPlane.
+func StewardControlPlaneAnnotations(kcp v1alpha2.StewardControlPlane) map[string]string {
+	return map[string]string{
+		StewardControlPlaneNameAnnotation: kcp.Name,
+	}
+}
+
+// ParseStewardControlPlaneFromTenantControlPlane returns the StewardControlPlane the remote TenantControlPlane belongs to,
+// false if it's not labelled as such: the name is read from the annotation, if any, since the label value could be hashed.
+func ParseStewardControlPlaneFromTenantControlPlane(tcp stewardv1alpha1.TenantControlPlane) (types.NamespacedName, bool) {
+	name, namespace := tcp.Labels[StewardControlPlaneNameLabel], tcp.Labels[StewardControlPlaneNamespaceLabel]
+	if annotation := tcp.Annotations[StewardControlPlaneNameAnnotation]; annotation != "" {
+		name = annotation
+	}
+
+	if name == "" || namespace == "" {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Name: name, Namespace: namespace}, true
+}
+
+// GenerateRemoteTenantControlPlaneNames returns the name of a new remote TenantControlPlane according to the naming strategy,
+// along with the deployment namespace.
+func GenerateRemoteTenantControlPlaneNames(kcp v1alpha2.StewardControlPlane) (name string, namespace string, err error) { //nolint:nonamedreturns
+	namespace = kcp.Spec.Deployment.ExternalClusterReference.DeploymentNamespace
+
+	naming := kcp.Spec.Deployment.ExternalClusterReference.Naming
+	if naming == nil {
+		return RemoteTCPPrefix + string(kcp.UID), namespace, nil
+	}
+
+	switch naming.Strategy {
+	case v1alpha2.NamespaceNameRemoteNamingStrategy:
+		name = namespaceName(kcp)
+	case v1alpha2.TemplateRemoteNamingStrategy:
+		if name, err = executeNamingTemplate(kcp, naming.Template); err != nil {
+			return "", "", err
+		}
+	default:
+		name = RemoteTCPPrefix + string(kcp.UID)
+	}
+
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return "", "", fmt.Errorf("invalid remote TenantControlPlane name %q: %s", name, strings.Join(errs, ", "))
+	}
+
+	return name, namespace, nil
+}
+
+// namespaceName returns <namespace>-<name>-<hash>: the hash of the namespaced name prevents the collisions,
+// such as the a-b/c and a/b-c StewardControlPlanes, and the readable prefix is truncated to fit a DNS label.
+func namespaceName(kcp v1alpha2.StewardControlPlane) string {
+	return hashedName(kcp.Namespace+"-"+kcp.Name, kcp.Namespace+"/"+kcp.Name, validation.DNS1123LabelMaxLength)
+}
+
+// hashedName returns <prefix>-<hash> of the given value, with the prefix truncated to fit the maximum length.
+func hashedName(prefix, value string, maxLength int) string {
+	sum := sha256.Sum256([]byte(value))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+
+	if maxPrefixLength := maxLength - len(hash) - 1; len(prefix) > maxPrefixLength {
+		prefix = strings.TrimRight(prefix[:maxPrefixLength], "-.")
+	}
+
+	return prefix + "-" + hash
+}
+
+// ValidateNamingTemplate ensures the naming template can be parsed.
+func ValidateNamingTemplate(value string) error {
+	_, err := template.New("naming").Option("missingkey=error").Parse(value)
+
+	return errors.Wrap(err, "cannot parse the naming template")
 }
 
-func GenerateRemoteTenantControlPlaneNames(kcp v1alpha1.StewardControlPlane) (name string, namespace string) { //nolint:nonamedreturns
-	return RemoteTCPPrefix + string(kcp.UID), kcp.Spec.Deployment.ExternalClusterReference.DeploymentNamespace
+func executeNamingTemplate(kcp v1alpha2.StewardControlPlane, value string) (string, error) {
+	tpl, err := template.New("naming").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot parse the naming template")
+	}
+
+	var clusterName string
+
+	for _, owner := range kcp.OwnerReferences {
+		if owner.Kind == "Cluster" {
+			clusterName = owner.Name
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if err = tpl.Execute(&buf, map[string]string{
+		"Name":        kcp.Name,
+		"Namespace":   kcp.Namespace,
+		"UID":         string(kcp.UID),
+		"ClusterName": clusterName,
+	}); err != nil {
+		return "", errors.Wrap(err, "cannot execute the naming template")
+	}
+
+	return strings.TrimSpace(buf.String()), nil
 }
 
 func GenerateKeyNameFromSecret(secret *corev1.Secret) []string {
@@ -38,12 +160,67 @@ func GenerateKeyNameFromSecret(secret *corev1.Secret) []string {
 	return names
 }
 
-func GenerateKeyNameFromSteward(kcp *v1alpha1.StewardControlPlane) string {
-	namespace := kcp.Namespace
+func GenerateKeyNameFromSteward(kcp *v1alpha2.StewardControlPlane) string {
+	return GenerateKeyName(kcp.Namespace, kcp.Spec.Deployment.ExternalClusterReference)
+}
+
+// GenerateKeyNamesFromSteward returns the names of the managers used by the StewardControlPlane,
+// including the source and destination external clusters during a migration, such as to roll it back.
+func GenerateKeyNamesFromSteward(kcp *v1alpha2.StewardControlPlane) []string {
+	var keys []string
+
+	if kcp.Spec.Deployment.ExternalClusterReference != nil {
+		keys = append(keys, GenerateKeyNameFromSteward(kcp))
+	}
+
+	if migration := kcp.Status.Migration; migration != nil {
+		for _, ref := range []*v1alpha2.ExternalClusterReference{migration.Source.ExternalClusterReference, migration.Destination.ExternalClusterReference} {
+			if ref == nil {
+				continue
+			}
+
+			if key := GenerateKeyName(kcp.Namespace, ref); !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
+
+// GenerateKeyName returns the name of the manager for the given ExternalClusterReference,
+// defined by a StewardControlPlane in the given namespace.
+func GenerateKeyName(namespace string, ref *v1alpha2.ExternalClusterReference) string {
+	namespace, name, key := KubeconfigSecretReference(namespace, ref)
+
+	return namespace + "/" + name + "/" + key
+}
+
+// KubeconfigSecretReference returns the Secret, and its key, providing the kubeconfig of the external cluster:
+// when referencing a Cluster API Cluster, the <cluster>-kubeconfig Secret is used.
+func KubeconfigSecretReference(namespace string, ref *v1alpha2.ExternalClusterReference) (string, string, string) {
+	if ref.ClusterRef != nil {
+		namespace, name := HostingClusterReference(namespace, ref)
+
+		return namespace, name + "-kubeconfig", "value"
+	}
+
+	if ref.KubeconfigSecretNamespace != "" {
+		namespace = ref.KubeconfigSecretNamespace
+	}
+
+	return namespace, ref.KubeconfigSecretName, ref.KubeconfigSecretKey
+}
+
+// HostingClusterReference returns the Cluster API Cluster hosting the TenantControlPlane, empty if not referenced.
+func HostingClusterReference(namespace string, ref *v1alpha2.ExternalClusterReference) (string, string) {
+	if ref == nil || ref.ClusterRef == nil {
+		return "", ""
+	}
 
-	if kcp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretNamespace != "" {
-		namespace = kcp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretNamespace
+	if ref.ClusterRef.Namespace != "" {
+		namespace = ref.ClusterRef.Namespace
 	}
 
-	return namespace + "/" + kcp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretName + "/" + kcp.Spec.Deployment.ExternalClusterReference.KubeconfigSecretKey
+	return namespace, ref.ClusterRef.Name
 }
@@ -0,0 +1,128 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package externalclusterreference
+
+import (
+	"strings"
+	"testing"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	. "github.com/onsi/gomega" //nolint:revive
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+func stewardControlPlane(namespace, name string, naming *v1alpha2.RemoteNamingSpec) v1alpha2.StewardControlPlane {
+	scp := v1alpha2.StewardControlPlane{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: "6f1c5d0e"}}
+	scp.Spec.Deployment.ExternalClusterReference = &v1alpha2.ExternalClusterReference{DeploymentNamespace: "tenants", Naming: naming}
+
+	return scp
+}
+
+func TestGenerateRemoteTenantControlPlaneNames(t *testing.T) {
+	namespaceName := &v1alpha2.RemoteNamingSpec{Strategy: v1alpha2.NamespaceNameRemoteNamingStrategy}
+
+	tests := []struct {
+		name     string
+		scp      v1alpha2.StewardControlPlane
+		expected string
+		invalid  bool
+	}{
+		{name: "default", scp: stewardControlPlane("default", "cluster", nil), expected: "kcp-6f1c5d0e"},
+		{name: "namespace and name", scp: stewardControlPlane("default", "cluster", namespaceName), expected: "default-cluster-96e22977"},
+		{
+			name: "template",
+			scp: stewardControlPlane("default", "cluster", &v1alpha2.RemoteNamingSpec{
+				Strategy: v1alpha2.TemplateRemoteNamingStrategy,
+				Template: "{{ .Namespace }}-{{ .Name }}-cp",
+			}),
+			expected: "default-cluster-cp",
+		},
+		{
+			name: "template with an invalid name",
+			scp: stewardControlPlane("default", "cluster", &v1alpha2.RemoteNamingSpec{
+				Strategy: v1alpha2.TemplateRemoteNamingStrategy,
+				Template: "{{ .Namespace }}/{{ .Name }}",
+			}),
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			name, namespace, err := GenerateRemoteTenantControlPlaneNames(tt.scp)
+			if tt.invalid {
+				g.Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(name).To(Equal(tt.expected))
+			g.Expect(namespace).To(Equal("tenants"))
+		})
+	}
+}
+
+func TestNamespaceNameRemoteNamingStrategy(t *testing.T) {
+	g := NewWithT(t)
+
+	naming := &v1alpha2.RemoteNamingSpec{Strategy: v1alpha2.NamespaceNameRemoteNamingStrategy}
+	// The namespaced names sharing the same concatenation don't collide.
+	first, _, err := GenerateRemoteTenantControlPlaneNames(stewardControlPlane("a-b", "c", naming))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	second, _, err := GenerateRemoteTenantControlPlaneNames(stewardControlPlane("a", "b-c", naming))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(first).To(HavePrefix("a-b-c-"))
+	g.Expect(second).To(HavePrefix("a-b-c-"))
+	g.Expect(first).NotTo(Equal(second))
+	// The longest namespace and name are truncated to a valid DNS label, still unique.
+	long, _, err := GenerateRemoteTenantControlPlaneNames(stewardControlPlane(strings.Repeat("n", 63), strings.Repeat("c", 63)+"-a", naming))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(validation.IsDNS1123Label(long)).To(BeEmpty())
+
+	other, _, err := GenerateRemoteTenantControlPlaneNames(stewardControlPlane(strings.Repeat("n", 63), strings.Repeat("c", 63)+"-b", naming))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(other).NotTo(Equal(long))
+	// The truncated prefix never ends with a dash.
+	dashed, _, err := GenerateRemoteTenantControlPlaneNames(stewardControlPlane(strings.Repeat("n", 53), "cluster", naming))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dashed).NotTo(ContainSubstring("--"))
+	g.Expect(validation.IsDNS1123Label(dashed)).To(BeEmpty())
+}
+
+func TestStewardControlPlaneLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	// The names fitting a label value are kept as they are, as labelled by the previous versions.
+	labels := StewardControlPlaneLabels(stewardControlPlane("default", "cluster", nil))
+	g.Expect(labels).To(HaveKeyWithValue(StewardControlPlaneNameLabel, "cluster"))
+	g.Expect(labels).To(HaveKeyWithValue(StewardControlPlaneNamespaceLabel, "default"))
+	// The longer names, such as the ClusterClass generated ones, are truncated and hashed to a valid label value.
+	long := stewardControlPlane("default", strings.Repeat("c", 62)+".a-control-plane", nil)
+	labels = StewardControlPlaneLabels(long)
+	g.Expect(validation.IsValidLabelValue(labels[StewardControlPlaneNameLabel])).To(BeEmpty())
+
+	other := StewardControlPlaneLabels(stewardControlPlane("default", strings.Repeat("c", 62)+".b-control-plane", nil))
+	g.Expect(other[StewardControlPlaneNameLabel]).NotTo(Equal(labels[StewardControlPlaneNameLabel]))
+	// The full name is parsed from the annotation.
+	tcp := stewardv1alpha1.TenantControlPlane{}
+	tcp.Labels, tcp.Annotations = labels, StewardControlPlaneAnnotations(long)
+
+	key, ok := ParseStewardControlPlaneFromTenantControlPlane(tcp)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(key).To(Equal(types.NamespacedName{Name: long.Name, Namespace: "default"}))
+	// The TenantControlPlanes labelled by the previous versions have no annotation.
+	tcp.Labels, tcp.Annotations = StewardControlPlaneLabels(stewardControlPlane("default", "cluster", nil)), nil
+
+	key, ok = ParseStewardControlPlaneFromTenantControlPlane(tcp)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(key).To(Equal(types.NamespacedName{Name: "cluster", Namespace: "default"}))
+}
@@ -6,29 +6,74 @@ package externalclusterreference
 import (
 	"context"
 	"sync"
+	"time"
 
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+const (
+	restartMinDelay = time.Second
+	restartMaxDelay = 5 * time.Minute
+)
+
 type instance struct {
 	ResourceVersion string
 	Manager         ctrl.Manager
 	StopFunc        func()
 }
 
+// Health is the state of a remote cluster manager, retained across its restarts.
+type Health struct {
+	// ResourceVersion of the Secret the manager has been created from.
+	ResourceVersion string
+	// Started is true while the manager is running.
+	Started bool
+	// CacheSynced is true once the manager cache has been synced.
+	CacheSynced bool
+	// LastError is the error which stopped the manager, if any.
+	LastError error
+	// Failures counts the consecutive failures, reset once the manager cache is synced.
+	Failures int
+	// NextRestart is the time the failed manager can be restarted.
+	NextRestart time.Time
+}
+
+// Failed returns true when the manager stopped due to an error, and must be restarted.
+func (h Health) Failed() bool {
+	return !h.Started && h.LastError != nil
+}
+
 type Store interface {
 	Get(name, rv string) (ctrl.Manager, bool)
 	Stop(name string) bool
 	Add(name, rv string, manager ctrl.Manager, cancelFn context.CancelFunc) bool
+	// Health returns the state of the named manager, false if it's unknown.
+	Health(name string) (Health, bool)
+	// MarkStarted records the named manager has been started.
+	MarkStarted(name string)
+	// MarkCacheSynced records the named manager cache has been synced, resetting the failures.
+	MarkCacheSynced(name string)
+	// MarkFailed removes the named manager due to the given error,
+	// returning the delay to wait before restarting it, exponentially increasing with the consecutive failures:
+	// false is returned when the failed manager has been superseded by one with a different resource version, left untouched.
+	MarkFailed(name, rv string, err error) (time.Duration, bool)
 }
 
 type mapStore struct {
-	store map[string]instance
-	mutex sync.RWMutex
+	store   map[string]instance
+	health  map[string]Health
+	backoff workqueue.TypedRateLimiter[string]
+	mutex   sync.RWMutex
 }
 
 func NewStore() Store { //nolint:ireturn
-	return &mapStore{store: map[string]instance{}, mutex: sync.RWMutex{}}
+	return &mapStore{
+		store:   map[string]instance{},
+		health:  map[string]Health{},
+		backoff: workqueue.NewTypedItemExponentialFailureRateLimiter[string](restartMinDelay, restartMaxDelay),
+		mutex:   sync.RWMutex{},
+	}
 }
 
 func (m *mapStore) Get(name, resourceVersion string) (ctrl.Manager, bool) { //nolint:ireturn
@@ -51,6 +96,9 @@ func (m *mapStore) Stop(name string) bool {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	delete(m.health, name)
+	m.backoff.Forget(name)
+
 	v, ok := m.store[name]
 	if !ok {
 		return false
@@ -76,6 +124,78 @@ func (m *mapStore) Add(name, resourceVersion string, manager ctrl.Manager, cance
 		Manager:         manager,
 		StopFunc:        cancelFn,
 	}
+	// Keeping track of the previous failures, until the new manager proves to be healthy.
+	health := m.health[name]
+	health.ResourceVersion = resourceVersion
+	health.Started, health.CacheSynced = false, false
+	m.health[name] = health
 
 	return true
 }
+
+func (m *mapStore) Health(name string) (Health, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	health, ok := m.health[name]
+
+	return health, ok
+}
+
+func (m *mapStore) MarkStarted(name string) {
+	m.update(name, func(health *Health) {
+		health.Started = true
+	})
+}
+
+func (m *mapStore) MarkCacheSynced(name string) {
+	m.update(name, func(health *Health) {
+		health.CacheSynced = true
+		health.LastError = nil
+		health.Failures = 0
+		health.NextRestart = time.Time{}
+
+		m.backoff.Forget(name)
+	})
+}
+
+func (m *mapStore) MarkFailed(name, resourceVersion string, err error) (time.Duration, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if v, ok := m.store[name]; ok {
+		if v.ResourceVersion != resourceVersion {
+			return 0, false
+		}
+
+		v.StopFunc()
+
+		delete(m.store, name)
+	}
+
+	delay := m.backoff.When(name)
+
+	health := m.health[name]
+	health.ResourceVersion = resourceVersion
+	health.Started, health.CacheSynced = false, false
+	health.LastError = err
+	health.Failures++
+	health.NextRestart = time.Now().Add(delay)
+	m.health[name] = health
+
+	return delay, true
+}
+
+// update changes the health of a known manager.
+func (m *mapStore) update(name string, fn func(health *Health)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	health, ok := m.health[name]
+	if !ok {
+		return
+	}
+
+	fn(&health)
+	m.health[name] = health
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package externalclusterreference
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega" //nolint:revive
+)
+
+func TestMarkFailed(t *testing.T) {
+	g := NewWithT(t)
+
+	store := NewStore()
+	stopped := map[string]bool{}
+
+	g.Expect(store.Add("default/hosting/kubeconfig", "1", nil, func() { stopped["1"] = true })).To(BeTrue())
+	// The superseded manager is replaced by the one with the changed configuration.
+	g.Expect(store.Stop("default/hosting/kubeconfig")).To(BeTrue())
+	g.Expect(store.Add("default/hosting/kubeconfig", "2", nil, func() { stopped["2"] = true })).To(BeTrue())
+	// The late failure of the superseded manager doesn't tear down its replacement.
+	_, current := store.MarkFailed("default/hosting/kubeconfig", "1", errors.New("connection refused"))
+	g.Expect(current).To(BeFalse())
+	g.Expect(stopped).NotTo(HaveKey("2"))
+
+	_, found := store.Get("default/hosting/kubeconfig", "2")
+	g.Expect(found).To(BeTrue())
+
+	health, _ := store.Health("default/hosting/kubeconfig")
+	g.Expect(health.Failed()).To(BeFalse())
+	// The failure of the current manager stops it, delaying its restart.
+	delay, current := store.MarkFailed("default/hosting/kubeconfig", "2", errors.New("connection refused"))
+	g.Expect(current).To(BeTrue())
+	g.Expect(delay).To(Equal(restartMinDelay))
+	g.Expect(stopped).To(HaveKey("2"))
+
+	_, found = store.Get("default/hosting/kubeconfig", "2")
+	g.Expect(found).To(BeFalse())
+
+	health, _ = store.Health("default/hosting/kubeconfig")
+	g.Expect(health.Failed()).To(BeTrue())
+	g.Expect(health.Failures).To(Equal(1))
+}
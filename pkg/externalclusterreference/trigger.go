@@ -0,0 +1,72 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package externalclusterreference
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// TriggerQueueName is the name of the queue, used by the workqueue metrics such as the depth one.
+	TriggerQueueName = "stewardcontrolplane_trigger"
+	triggerQPS       = 10
+	triggerBurst     = 100
+)
+
+// Trigger notifies the StewardControlPlane controller about the changes occurring in the external clusters,
+// without blocking the remote managers: the notifications are buffered, deduplicated by StewardControlPlane, and rate limited.
+type Trigger struct {
+	queue workqueue.TypedInterface[types.NamespacedName]
+	// limiter throttles the forwarding to the controller queue, rather than the notifications:
+	// the duplicated ones never consume the tokens, delaying the other StewardControlPlanes.
+	limiter *rate.Limiter
+}
+
+func NewTrigger() *Trigger {
+	return &Trigger{
+		queue:   workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[types.NamespacedName]{Name: TriggerQueueName}),
+		limiter: rate.NewLimiter(rate.Limit(triggerQPS), triggerBurst),
+	}
+}
+
+// Notify enqueues the given StewardControlPlane, a no-op when it's already pending.
+func (t *Trigger) Notify(key types.NamespacedName) {
+	t.queue.Add(key)
+}
+
+// Start forwards the notifications to the controller queue, until the context is done:
+// it implements the controller-runtime source interface.
+func (t *Trigger) Start(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+	go func() {
+		<-ctx.Done()
+
+		t.queue.ShutDown()
+	}()
+
+	go func() {
+		for {
+			key, shutdown := t.queue.Get()
+			if shutdown {
+				return
+			}
+
+			if err := t.limiter.Wait(ctx); err != nil {
+				t.queue.Done(key)
+
+				return
+			}
+
+			queue.Add(reconcile.Request{NamespacedName: key})
+
+			t.queue.Done(key)
+		}
+	}()
+
+	return nil
+}
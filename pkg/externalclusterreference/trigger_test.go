@@ -0,0 +1,62 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package externalclusterreference
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega" //nolint:revive
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestTriggerNotify(t *testing.T) {
+	g := NewWithT(t)
+
+	trigger := NewTrigger()
+	defer trigger.queue.ShutDown()
+	// The burst of notifications of the same StewardControlPlane is merged into the pending one.
+	for range 1000 {
+		trigger.Notify(types.NamespacedName{Name: "noisy", Namespace: "default"})
+	}
+
+	g.Expect(trigger.queue.Len()).To(Equal(1))
+	// The other StewardControlPlanes are enqueued right away, without being delayed by the burst.
+	for i := range 2 * triggerBurst {
+		trigger.Notify(types.NamespacedName{Name: fmt.Sprintf("cluster-%d", i), Namespace: "default"})
+	}
+
+	g.Expect(trigger.queue.Len()).To(Equal(1 + 2*triggerBurst))
+}
+
+func TestTriggerStart(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer queue.ShutDown()
+
+	trigger := NewTrigger()
+	// Only the burst is forwarded, the refill being negligible.
+	trigger.limiter = rate.NewLimiter(rate.Every(time.Hour), triggerBurst)
+
+	for i := range 2 * triggerBurst {
+		trigger.Notify(types.NamespacedName{Name: fmt.Sprintf("cluster-%d", i), Namespace: "default"})
+	}
+
+	g.Expect(trigger.Start(ctx, queue)).To(Succeed())
+	// The forwarding to the controller queue is rate limited, the remaining notifications being kept pending.
+	g.Eventually(queue.Len).Should(Equal(triggerBurst))
+	g.Consistently(queue.Len, 200*time.Millisecond).Should(Equal(triggerBurst))
+	// The pending notifications are still merged, one of them waiting for the limiter.
+	trigger.Notify(types.NamespacedName{Name: fmt.Sprintf("cluster-%d", 2*triggerBurst-1), Namespace: "default"})
+	g.Expect(trigger.queue.Len()).To(Equal(triggerBurst - 1))
+}
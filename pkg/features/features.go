@@ -18,4 +18,8 @@ const (
 	// DynamicInfrastructureClusterPatch allows patching any generic InfraCluster with the control-plane endpoint
 	// provided by Steward.
 	DynamicInfrastructureClusterPatch = "DynamicInfrastructureClusterPatch"
+
+	// RuntimeSDK enables calling the Cluster API Runtime SDK lifecycle hooks, such as BeforeClusterUpgrade,
+	// for the Cluster objects not relying on ClusterClass.
+	RuntimeSDK = "RuntimeSDK"
 )
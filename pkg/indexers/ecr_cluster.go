@@ -0,0 +1,37 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package indexers
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	ecr "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
+)
+
+const (
+	ExternalClusterReferenceClusterField = "externalClusterReferenceCluster"
+)
+
+type ExternalClusterReferenceCluster struct{}
+
+func (e ExternalClusterReferenceCluster) Object() client.Object { //nolint:ireturn
+	return &scpv1alpha2.StewardControlPlane{}
+}
+
+func (e ExternalClusterReferenceCluster) Field() string {
+	return ExternalClusterReferenceClusterField
+}
+
+func (e ExternalClusterReferenceCluster) ExtractValue() client.IndexerFunc {
+	return func(object client.Object) []string {
+		kcp := object.(*scpv1alpha2.StewardControlPlane) //nolint:forcetypeassert
+
+		if namespace, name := ecr.HostingClusterReference(kcp.Namespace, kcp.Spec.Deployment.ExternalClusterReference); name != "" {
+			return []string{namespace + "/" + name}
+		}
+
+		return nil
+	}
+}
@@ -6,7 +6,7 @@ package indexers
 import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	scpv1alpha1 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha1"
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
 	ecr "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
 )
 
@@ -17,7 +17,7 @@ const (
 type ExternalClusterReferenceStewardControlPlane struct{}
 
 func (e ExternalClusterReferenceStewardControlPlane) Object() client.Object { //nolint:ireturn
-	return &scpv1alpha1.StewardControlPlane{}
+	return &scpv1alpha2.StewardControlPlane{}
 }
 
 func (e ExternalClusterReferenceStewardControlPlane) Field() string {
@@ -26,12 +26,8 @@ func (e ExternalClusterReferenceStewardControlPlane) Field() string {
 
 func (e ExternalClusterReferenceStewardControlPlane) ExtractValue() client.IndexerFunc {
 	return func(object client.Object) []string {
-		kcp := object.(*scpv1alpha1.StewardControlPlane) //nolint:forcetypeassert
+		kcp := object.(*scpv1alpha2.StewardControlPlane) //nolint:forcetypeassert
 
-		if kcp.Spec.Deployment.ExternalClusterReference != nil {
-			return []string{ecr.GenerateKeyNameFromSteward(kcp)}
-		}
-
-		return nil
+		return ecr.GenerateKeyNamesFromSteward(kcp)
 	}
 }
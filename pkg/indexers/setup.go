@@ -15,7 +15,7 @@ func SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	for _, indexer := range []indexers.Indexer{
 		ExternalClusterReferenceStewardControlPlane{},
 		ExternalClusterReferenceSecret{},
-		StewardControlPlaneUID{},
+		ExternalClusterReferenceCluster{},
 	} {
 		if err := mgr.GetFieldIndexer().IndexField(ctx, indexer.Object(), indexer.Field(), indexer.ExtractValue()); err != nil {
 			return errors.Wrap(err, "failed to set up indexer "+indexer.Field())
@@ -0,0 +1,267 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimehooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/transport"
+	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var ErrFailureResponse = errors.New("extension handler returned a failure response")
+
+//+kubebuilder:rbac:groups=runtime.cluster.x-k8s.io,resources=extensionconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get
+
+// Client calls the Runtime Extensions registered in Cluster API with the ExtensionConfig objects:
+// the discovery of the handlers is performed by the Cluster API core controller, and stored in the status,
+// which is used as registry since the Cluster API runtime client and registry implementations are internal to its module.
+type Client struct {
+	client client.Reader
+	// apiReader retrieves the Namespace of the object, without caching all the Namespaces of the cluster.
+	apiReader client.Reader
+	catalog   *runtimecatalog.Catalog
+}
+
+func NewClient(c, apiReader client.Reader) (*Client, error) {
+	catalog := runtimecatalog.New()
+
+	if err := runtimehooksv1.AddToCatalog(catalog); err != nil {
+		return nil, errors.Wrap(err, "cannot build the Runtime Hooks catalog")
+	}
+
+	return &Client{client: c, apiReader: apiReader, catalog: catalog}, nil
+}
+
+// CallAllExtensions calls all the extension handlers registered for the given hook, aggregating the responses:
+// for the blocking hooks the lowest non-zero retryAfterSeconds is returned.
+func (c *Client) CallAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) error { //nolint:cyclop
+	gvh, err := c.catalog.GroupVersionHook(hook)
+	if err != nil {
+		return errors.Wrap(err, "cannot compute the GroupVersionHook")
+	}
+
+	requestGVK, err := c.catalog.Request(gvh)
+	if err != nil {
+		return errors.Wrap(err, "cannot compute the request GroupVersionKind")
+	}
+
+	request.GetObjectKind().SetGroupVersionKind(requestGVK)
+
+	var extensionConfigs runtimev1.ExtensionConfigList
+	if err = c.client.List(ctx, &extensionConfigs); err != nil {
+		return errors.Wrap(err, "cannot list ExtensionConfig objects")
+	}
+
+	var namespace *corev1.Namespace
+
+	var retryAfterSeconds int32
+
+	for _, extensionConfig := range extensionConfigs.Items {
+		// The Namespace is retrieved only once required by a selector.
+		if extensionConfig.Spec.NamespaceSelector != nil && namespace == nil {
+			namespace = &corev1.Namespace{}
+			if err = c.apiReader.Get(ctx, client.ObjectKey{Name: forObject.GetNamespace()}, namespace); err != nil {
+				return errors.Wrap(err, "cannot retrieve the Namespace of the object")
+			}
+		}
+
+		matches, selectorErr := namespaceMatches(extensionConfig.Spec.NamespaceSelector, namespace)
+		if selectorErr != nil {
+			return selectorErr
+		}
+
+		if !matches {
+			continue
+		}
+
+		for _, handler := range extensionConfig.Status.Handlers {
+			if handler.RequestHook.APIVersion != gvh.GroupVersion().String() || handler.RequestHook.Hook != gvh.Hook {
+				continue
+			}
+
+			handlerResponse := response.DeepCopyObject().(runtimehooksv1.ResponseObject) //nolint:forcetypeassert
+
+			if err = c.callExtension(ctx, gvh, extensionConfig, handler, request, handlerResponse); err != nil {
+				return err
+			}
+
+			if retryResponse, ok := handlerResponse.(runtimehooksv1.RetryResponseObject); ok {
+				if value := retryResponse.GetRetryAfterSeconds(); value != 0 && (retryAfterSeconds == 0 || value < retryAfterSeconds) {
+					retryAfterSeconds = value
+				}
+			}
+		}
+	}
+
+	response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+
+	if retryResponse, ok := response.(runtimehooksv1.RetryResponseObject); ok {
+		retryResponse.SetRetryAfterSeconds(retryAfterSeconds)
+	}
+
+	return nil
+}
+
+func (c *Client) callExtension(ctx context.Context, gvh runtimecatalog.GroupVersionHook, extensionConfig runtimev1.ExtensionConfig, handler runtimev1.ExtensionHandler, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("extensionHandler", handler.Name, "hook", gvh.Hook)
+
+	timeout := runtimehooksv1.DefaultHandlersTimeoutSeconds * time.Second
+	if handler.TimeoutSeconds != nil {
+		timeout = time.Duration(*handler.TimeoutSeconds) * time.Second
+	}
+	// The values in the request take precedence over the ones from the registration.
+	request = request.DeepCopyObject().(runtimehooksv1.RequestObject) //nolint:forcetypeassert
+
+	settings := map[string]string{}
+	for k, v := range extensionConfig.Spec.Settings {
+		settings[k] = v
+	}
+
+	for k, v := range request.GetSettings() {
+		settings[k] = v
+	}
+
+	request.SetSettings(settings)
+
+	err := httpCall(ctx, extensionConfig.Spec.ClientConfig, runtimecatalog.GVHToPath(gvh, strings.TrimSuffix(handler.Name, "."+extensionConfig.Name)), timeout, request, response)
+	if err != nil {
+		if handler.FailurePolicy != nil && *handler.FailurePolicy == runtimev1.FailurePolicyIgnore {
+			log.Error(err, "ignoring error calling extension handler due to the failure policy")
+
+			response.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+			response.SetMessage("")
+
+			return nil
+		}
+
+		return errors.Wrapf(err, "cannot call extension handler %q", handler.Name)
+	}
+
+	if response.GetStatus() == runtimehooksv1.ResponseStatusFailure {
+		log.Info("extension handler returned a failure response", "message", response.GetMessage())
+
+		return errors.Wrapf(ErrFailureResponse, "extension handler %q", handler.Name)
+	}
+
+	return nil
+}
+
+func httpCall(ctx context.Context, config runtimev1.ClientConfig, handlerPath string, timeout time.Duration, request, response any) error {
+	extensionURL, err := urlForExtension(config, handlerPath)
+	if err != nil {
+		return err
+	}
+
+	values := extensionURL.Query()
+	values.Add("timeout", timeout.String())
+	extensionURL.RawQuery = values.Encode()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal request")
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, extensionURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrap(err, "cannot create HTTP request")
+	}
+
+	tlsConfig, err := transport.TLSConfigFor(&transport.Config{
+		TLS: transport.TLSConfig{
+			CAData:     config.CABundle,
+			ServerName: extensionURL.Hostname(),
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot create TLS configuration")
+	}
+
+	httpClient := &http.Client{Transport: utilnet.SetTransportDefaults(&http.Transport{TLSClientConfig: tlsConfig})}
+
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return errors.Wrap(err, "HTTP call failed")
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return errors.Errorf("HTTP call failed with status code %d", httpResponse.StatusCode)
+	}
+
+	if err = json.NewDecoder(httpResponse.Body).Decode(response); err != nil {
+		return errors.Wrap(err, "cannot decode response")
+	}
+
+	return nil
+}
+
+func urlForExtension(config runtimev1.ClientConfig, handlerPath string) (*url.URL, error) {
+	var extensionURL *url.URL
+
+	switch {
+	case config.Service != nil:
+		host := config.Service.Name + "." + config.Service.Namespace + ".svc"
+		if config.Service.Port != nil {
+			host = net.JoinHostPort(host, strconv.Itoa(int(*config.Service.Port)))
+		}
+
+		extensionURL = &url.URL{Scheme: "https", Host: host}
+		if config.Service.Path != nil {
+			extensionURL.Path = *config.Service.Path
+		}
+	case config.URL != nil:
+		var err error
+
+		if extensionURL, err = url.Parse(*config.URL); err != nil {
+			return nil, errors.Wrap(err, "cannot parse the extension URL")
+		}
+
+		if extensionURL.Scheme != "https" {
+			return nil, fmt.Errorf("expected https scheme, got %s", extensionURL.Scheme)
+		}
+	default:
+		return nil, errors.New("at least one of service and url must be defined")
+	}
+
+	extensionURL.Path = path.Join(extensionURL.Path, handlerPath)
+
+	return extensionURL, nil
+}
+
+func namespaceMatches(selector *metav1.LabelSelector, namespace *corev1.Namespace) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid namespace selector")
+	}
+
+	return s.Matches(labels.Set(namespace.GetLabels())), nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimehooks
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega" //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// extensionServer serves the BeforeClusterUpgrade handlers, replying with the response of the given handler name.
+func extensionServer(t *testing.T, responses map[string]*runtimehooksv1.BeforeClusterUpgradeResponse) (*httptest.Server, map[string]int) {
+	t.Helper()
+
+	calls := map[string]int{}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for name, response := range responses {
+			if r.URL.Path != runtimecatalog.GVHToPath(runtimecatalog.GroupVersionHook{
+				Group:   runtimehooksv1.GroupVersion.Group,
+				Version: runtimehooksv1.GroupVersion.Version,
+				Hook:    "BeforeClusterUpgrade",
+			}, name) {
+				continue
+			}
+
+			calls[name]++
+
+			_ = json.NewEncoder(w).Encode(response)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, calls
+}
+
+func extensionConfig(server *httptest.Server, name string, selector *metav1.LabelSelector, handlers ...runtimev1.ExtensionHandler) *runtimev1.ExtensionConfig {
+	for i := range handlers {
+		handlers[i].Name += "." + name
+		handlers[i].RequestHook = runtimev1.GroupVersionHook{APIVersion: runtimehooksv1.GroupVersion.String(), Hook: "BeforeClusterUpgrade"}
+	}
+
+	return &runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				URL:      ptr.To(server.URL),
+				CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+			},
+			NamespaceSelector: selector,
+		},
+		Status: runtimev1.ExtensionConfigStatus{Handlers: handlers},
+	}
+}
+
+func retryResponse(seconds int32) *runtimehooksv1.BeforeClusterUpgradeResponse {
+	response := &runtimehooksv1.BeforeClusterUpgradeResponse{}
+	response.Status = runtimehooksv1.ResponseStatusSuccess
+	response.RetryAfterSeconds = seconds
+
+	return response
+}
+
+func TestCallAllExtensions(t *testing.T) {
+	failure := &runtimehooksv1.BeforeClusterUpgradeResponse{}
+	failure.Status, failure.Message = runtimehooksv1.ResponseStatusFailure, "not yet"
+
+	server, calls := extensionServer(t, map[string]*runtimehooksv1.BeforeClusterUpgradeResponse{
+		"ready":   retryResponse(0),
+		"slow":    retryResponse(30),
+		"fast":    retryResponse(10),
+		"failure": failure,
+	})
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenants", Labels: map[string]string{"tier": "gold"}}}
+
+	tests := []struct {
+		name       string
+		configs    []*runtimev1.ExtensionConfig
+		retryAfter int32
+		err        error
+		called     []string
+	}{
+		{
+			name: "lowest non-zero retry",
+			configs: []*runtimev1.ExtensionConfig{
+				extensionConfig(server, "first", nil, runtimev1.ExtensionHandler{Name: "slow"}, runtimev1.ExtensionHandler{Name: "ready"}),
+				extensionConfig(server, "second", nil, runtimev1.ExtensionHandler{Name: "fast"}),
+			},
+			retryAfter: 10,
+			called:     []string{"slow", "ready", "fast"},
+		},
+		{
+			name: "failure response",
+			configs: []*runtimev1.ExtensionConfig{
+				extensionConfig(server, "first", nil, runtimev1.ExtensionHandler{Name: "failure"}),
+			},
+			err:    ErrFailureResponse,
+			called: []string{"failure"},
+		},
+		{
+			name: "unreachable handler ignored by the failure policy",
+			configs: []*runtimev1.ExtensionConfig{
+				extensionConfig(server, "first", nil, runtimev1.ExtensionHandler{Name: "missing", FailurePolicy: ptr.To(runtimev1.FailurePolicyIgnore)}),
+				extensionConfig(server, "second", nil, runtimev1.ExtensionHandler{Name: "fast"}),
+			},
+			retryAfter: 10,
+			called:     []string{"fast"},
+		},
+		{
+			name: "namespace selector",
+			configs: []*runtimev1.ExtensionConfig{
+				extensionConfig(server, "first", &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "silver"}}, runtimev1.ExtensionHandler{Name: "slow"}),
+				extensionConfig(server, "second", &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}}, runtimev1.ExtensionHandler{Name: "fast"}),
+			},
+			retryAfter: 10,
+			called:     []string{"fast"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = runtimev1.AddToScheme(scheme)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clear(calls)
+
+			builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace)
+			for _, config := range tt.configs {
+				builder = builder.WithObjects(config).WithStatusSubresource(config)
+			}
+
+			c := builder.Build()
+
+			runtimeClient, err := NewClient(c, c)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			response := &runtimehooksv1.BeforeClusterUpgradeResponse{}
+			err = runtimeClient.CallAllExtensions(context.Background(), runtimehooksv1.BeforeClusterUpgrade,
+				&metav1.ObjectMeta{Name: "cluster", Namespace: namespace.Name}, &runtimehooksv1.BeforeClusterUpgradeRequest{}, response)
+
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(response.GetRetryAfterSeconds()).To(Equal(tt.retryAfter))
+			}
+
+			for _, name := range tt.called {
+				g.Expect(calls).To(HaveKeyWithValue(name, 1))
+			}
+
+			g.Expect(calls).To(HaveLen(len(tt.called)))
+		})
+	}
+}
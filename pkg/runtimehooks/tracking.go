@@ -0,0 +1,77 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package runtimehooks
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The intent to call a hook is tracked with the same annotation used by Cluster API,
+// the hook is removed once the call completes successfully.
+
+func IsPending(hook runtimecatalog.Hook, obj client.Object) bool {
+	return pendingHooks(obj).Has(runtimecatalog.HookName(hook))
+}
+
+func MarkAsPending(ctx context.Context, c client.Client, obj client.Object, hooks ...runtimecatalog.Hook) error {
+	return patchPendingHooks(ctx, c, obj, func(pending sets.Set[string]) {
+		for _, hook := range hooks {
+			pending.Insert(runtimecatalog.HookName(hook))
+		}
+	})
+}
+
+func MarkAsDone(ctx context.Context, c client.Client, obj client.Object, hooks ...runtimecatalog.Hook) error {
+	return patchPendingHooks(ctx, c, obj, func(pending sets.Set[string]) {
+		for _, hook := range hooks {
+			pending.Delete(runtimecatalog.HookName(hook))
+		}
+	})
+}
+
+func pendingHooks(obj client.Object) sets.Set[string] {
+	pending := sets.New[string]()
+
+	if value := obj.GetAnnotations()[runtimev1.PendingHooksAnnotation]; value != "" {
+		pending.Insert(strings.Split(value, ",")...)
+	}
+
+	return pending
+}
+
+func patchPendingHooks(ctx context.Context, c client.Client, obj client.Object, fn func(pending sets.Set[string])) error {
+	original, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.New("cannot copy the object")
+	}
+
+	pending := pendingHooks(obj)
+	fn(pending)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	if pending.Len() == 0 {
+		delete(annotations, runtimev1.PendingHooksAnnotation)
+	} else {
+		annotations[runtimev1.PendingHooksAnnotation] = strings.Join(sets.List(pending), ",")
+	}
+
+	obj.SetAnnotations(annotations)
+
+	if err := c.Patch(ctx, obj, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "cannot patch the pending hooks annotation")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+var (
+	ErrVersionDowngrade  = errors.New("downgrading the Kubernetes version is not supported")
+	ErrVersionSkew       = errors.New("the Kubernetes version can be upgraded one minor version at a time")
+	ErrUpgradeInProgress = errors.New("the Kubernetes version cannot be changed until the previous upgrade is completed")
+	ErrUnparsableVersion = errors.New("the Kubernetes version is not a valid semantic version")
+)
+
+// ValidateVersionChange enforces the Kubernetes version skew policy for the control plane:
+// running is the version reported as running by Steward, current is the version currently requested,
+// and desired is the new requested one. Empty running and current versions are tolerated, since the control plane
+// may still be provisioning, or not yet created at all.
+func ValidateVersionChange(running, current, desired string) error {
+	if current == "" {
+		return nil
+	}
+
+	desiredVersion, err := version.ParseSemantic(desired)
+	if err != nil {
+		return errors.Wrap(ErrUnparsableVersion, desired)
+	}
+
+	currentVersion, err := version.ParseSemantic(current)
+	if err != nil {
+		return errors.Wrap(ErrUnparsableVersion, current)
+	}
+
+	if desiredVersion.EqualTo(currentVersion) {
+		return nil
+	}
+
+	base := currentVersion
+
+	if running != "" {
+		runningVersion, rErr := version.ParseSemantic(running)
+		if rErr != nil {
+			return errors.Wrap(ErrUnparsableVersion, running)
+		}
+
+		if !runningVersion.EqualTo(currentVersion) {
+			return errors.Wrap(ErrUpgradeInProgress, fmt.Sprintf("running %s, requested %s", running, current))
+		}
+
+		base = runningVersion
+	}
+
+	if desiredVersion.LessThan(base) {
+		return errors.Wrap(ErrVersionDowngrade, fmt.Sprintf("from %s to %s", base, desired))
+	}
+
+	if desiredVersion.Major() != base.Major() || desiredVersion.Minor() > base.Minor()+1 {
+		return errors.Wrap(ErrVersionSkew, fmt.Sprintf("from %s to %s", base, desired))
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega" //nolint:revive
+)
+
+func TestValidateVersionChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		running  string
+		current  string
+		desired  string
+		expected error
+	}{
+		{name: "not yet created", desired: "v1.30.2"},
+		{name: "unchanged", running: "v1.30.2", current: "v1.30.2", desired: "v1.30.2"},
+		{name: "unchanged without prefix", running: "v1.30.2", current: "v1.30.2", desired: "1.30.2"},
+		{name: "patch-only bump", running: "v1.30.2", current: "v1.30.2", desired: "v1.30.5"},
+		{name: "next minor", running: "v1.30.2", current: "v1.30.2", desired: "v1.31.0"},
+		{name: "next minor while provisioning", current: "v1.30.2", desired: "v1.31.0"},
+		{name: "pre-release of the next minor", running: "v1.30.2", current: "v1.30.2", desired: "v1.31.0-rc.1"},
+		{name: "release of the running pre-release", running: "v1.31.0-rc.1", current: "v1.31.0-rc.1", desired: "v1.31.0"},
+		{name: "two minors at once", running: "v1.30.2", current: "v1.30.2", desired: "v1.32.0", expected: ErrVersionSkew},
+		{name: "next major", running: "v1.30.2", current: "v1.30.2", desired: "v2.0.0", expected: ErrVersionSkew},
+		{name: "minor downgrade", running: "v1.30.2", current: "v1.30.2", desired: "v1.29.8", expected: ErrVersionDowngrade},
+		{name: "patch downgrade", running: "v1.30.2", current: "v1.30.2", desired: "v1.30.1", expected: ErrVersionDowngrade},
+		{name: "pre-release downgrade", running: "v1.31.0", current: "v1.31.0", desired: "v1.31.0-rc.1", expected: ErrVersionDowngrade},
+		{name: "upgrade in progress", running: "v1.30.2", current: "v1.31.0", desired: "v1.31.1", expected: ErrUpgradeInProgress},
+		{name: "rollback of the upgrade in progress", running: "v1.30.2", current: "v1.31.0", desired: "v1.30.2", expected: ErrUpgradeInProgress},
+		{name: "unparsable desired version", running: "v1.30.2", current: "v1.30.2", desired: "latest", expected: ErrUnparsableVersion},
+		{name: "unparsable running version", running: "unknown", current: "v1.30.2", desired: "v1.30.3", expected: ErrUnparsableVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := ValidateVersionChange(tt.running, tt.current, tt.desired)
+			if tt.expected == nil {
+				g.Expect(err).NotTo(HaveOccurred())
+
+				return
+			}
+
+			g.Expect(err).To(MatchError(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"strings"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+const (
+	DefaultReplicas              = int32(2)
+	DefaultContainerRegistry     = "registry.k8s.io"
+	DefaultCGroupDriver          = "systemd"
+	DefaultIngressControllerType = "generic"
+
+	DefaultRemediationTimeout     = 10 * time.Minute
+	DefaultRemediationRetryPeriod = 5 * time.Minute
+	DefaultRemediationMaxRetries  = int32(3)
+	DefaultRemediationAction      = scpv1alpha2.RollingRestartRemediationAction
+
+	DefaultUserKubeconfigUsername            = "capi-user"
+	DefaultUserKubeconfigCertificateValidity = 365 * 24 * time.Hour
+	DefaultUserKubeconfigExecAPIVersion      = "client.authentication.k8s.io/v1"
+	DefaultUserKubeconfigExecInteractiveMode = "IfAvailable"
+)
+
+// DefaultKubeletPreferredAddressTypes mirrors the order used by Steward when no preference is expressed.
+var DefaultKubeletPreferredAddressTypes = []stewardv1alpha1.KubeletPreferredAddressType{
+	stewardv1alpha1.NodeInternalIP,
+	stewardv1alpha1.NodeExternalIP,
+	stewardv1alpha1.NodeHostName,
+}
+
+// defaultVersion canonicalizes the Kubernetes version with the "v" prefix,
+// the same value the controller applies to the TenantControlPlane.
+func defaultVersion(value string) string {
+	if value == "" || strings.HasPrefix(value, "v") {
+		return value
+	}
+
+	return "v" + value
+}
+
+// defaultStewardControlPlaneFields fills the fields shared between StewardControlPlane and StewardControlPlaneTemplate,
+// not only when the parent object is missing as it happens with the CRD default markers.
+func defaultStewardControlPlaneFields(fields *scpv1alpha2.StewardControlPlaneFields) {
+	if fields.ContainerRegistry == "" {
+		fields.ContainerRegistry = DefaultContainerRegistry
+	}
+
+	if len(fields.Kubelet.PreferredAddressTypes) == 0 {
+		fields.Kubelet.PreferredAddressTypes = append([]stewardv1alpha1.KubeletPreferredAddressType{}, DefaultKubeletPreferredAddressTypes...)
+	}
+	// The cgroup driver is deprecated in favour of the JSON patches:
+	// defaulting it only when no patches are provided to avoid overlapping configurations.
+	if fields.Kubelet.CGroupFS == "" && len(fields.Kubelet.ConfigurationJSONPatches) == 0 {
+		fields.Kubelet.CGroupFS = DefaultCGroupDriver
+	}
+
+	if fields.Network.ServiceType == "" {
+		fields.Network.ServiceType = stewardv1alpha1.ServiceTypeLoadBalancer
+	}
+
+	if ingress := fields.Network.Ingress; ingress != nil && ingress.ControllerType == "" {
+		ingress.ControllerType = DefaultIngressControllerType
+	}
+
+	if fields.CertificateAuthority.Source == "" {
+		fields.CertificateAuthority.Source = scpv1alpha2.StewardCertificateAuthoritySource
+	}
+
+	if fields.CertificateAuthority.Replication == "" {
+		fields.CertificateAuthority.Replication = scpv1alpha2.KeyPairCertificateAuthorityReplication
+	}
+
+	if remediation := fields.Remediation; remediation != nil {
+		defaultRemediation(remediation)
+	}
+
+	if userKubeconfig := fields.UserKubeconfig; userKubeconfig != nil {
+		defaultUserKubeconfig(userKubeconfig)
+	}
+}
+
+func defaultUserKubeconfig(userKubeconfig *scpv1alpha2.UserKubeconfigSpec) {
+	if userKubeconfig.Username == "" {
+		userKubeconfig.Username = DefaultUserKubeconfigUsername
+	}
+
+	if userKubeconfig.CertificateValidity == nil {
+		userKubeconfig.CertificateValidity = &metav1.Duration{Duration: DefaultUserKubeconfigCertificateValidity}
+	}
+
+	if exec := userKubeconfig.Exec; exec != nil {
+		if exec.APIVersion == "" {
+			exec.APIVersion = DefaultUserKubeconfigExecAPIVersion
+		}
+
+		if exec.InteractiveMode == "" {
+			exec.InteractiveMode = DefaultUserKubeconfigExecInteractiveMode
+		}
+	}
+}
+
+func defaultRemediation(remediation *scpv1alpha2.RemediationSpec) {
+	if remediation.Timeout == nil {
+		remediation.Timeout = &metav1.Duration{Duration: DefaultRemediationTimeout}
+	}
+
+	if remediation.RetryPeriod == nil {
+		remediation.RetryPeriod = &metav1.Duration{Duration: DefaultRemediationRetryPeriod}
+	}
+
+	if remediation.MaxRetries == nil {
+		remediation.MaxRetries = ptr.To(DefaultRemediationMaxRetries)
+	}
+
+	if remediation.Action == "" {
+		remediation.Action = DefaultRemediationAction
+	}
+}
+
+func defaultStewardControlPlaneSpec(spec *scpv1alpha2.StewardControlPlaneSpec) {
+	spec.Version = defaultVersion(spec.Version)
+
+	if spec.Replicas == nil {
+		spec.Replicas = ptr.To(DefaultReplicas)
+	}
+
+	defaultStewardControlPlaneFields(&spec.StewardControlPlaneFields)
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/upgrade"
+)
+
+//+kubebuilder:webhook:path=/mutate-controlplane-cluster-x-k8s-io-v1alpha2-stewardcontrolplane,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,sideEffects=None,groups=controlplane.cluster.x-k8s.io,resources=stewardcontrolplanes,verbs=create;update,versions=v1alpha2,name=default.stewardcontrolplane.controlplane.cluster.x-k8s.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-controlplane-cluster-x-k8s-io-v1alpha2-stewardcontrolplane,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,sideEffects=None,groups=controlplane.cluster.x-k8s.io,resources=stewardcontrolplanes,verbs=create;update,versions=v1alpha2,name=validation.stewardcontrolplane.controlplane.cluster.x-k8s.io,admissionReviewVersions=v1
+
+// StewardControlPlane implements the defaulting and validating webhooks for the StewardControlPlane resource:
+// defaults reflect what the controller applies to the TenantControlPlane, and validation rejects at admission time
+// the specifications which would be refused later by Steward.
+type StewardControlPlane struct{}
+
+var (
+	_ webhook.CustomDefaulter = &StewardControlPlane{}
+	_ webhook.CustomValidator = &StewardControlPlane{}
+)
+
+func (w *StewardControlPlane) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	//nolint:wrapcheck
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&scpv1alpha2.StewardControlPlane{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+func (w *StewardControlPlane) Default(_ context.Context, obj runtime.Object) error {
+	scp, ok := obj.(*scpv1alpha2.StewardControlPlane)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlane but got a %T", obj))
+	}
+
+	defaultStewardControlPlaneSpec(&scp.Spec)
+
+	return nil
+}
+
+func (w *StewardControlPlane) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	scp, ok := obj.(*scpv1alpha2.StewardControlPlane)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlane but got a %T", obj))
+	}
+
+	return nil, w.validate(scp, nil)
+}
+
+func (w *StewardControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldSCP, ok := oldObj.(*scpv1alpha2.StewardControlPlane)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlane but got a %T", oldObj))
+	}
+
+	scp, ok := newObj.(*scpv1alpha2.StewardControlPlane)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlane but got a %T", newObj))
+	}
+
+	// The object being deleted is only expected to get its finalizers removed,
+	// which must be allowed even when its specification is no longer valid.
+	if scp.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	return nil, w.validate(scp, oldSCP)
+}
+
+func (w *StewardControlPlane) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *StewardControlPlane) validate(scp, oldSCP *scpv1alpha2.StewardControlPlane) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validateStewardControlPlaneSpec(scp.Spec, specPath)
+	// Enforcing the rules only on the changed fields, the objects persisted before the introduction of a rule must be updatable.
+	if oldSCP != nil {
+		allErrs = ratchetErrors(allErrs, validateStewardControlPlaneSpec(oldSCP.Spec, specPath))
+	}
+	// Enforcing the version skew policy against the running version of the Tenant Control Plane
+	if oldSCP != nil && len(allErrs) == 0 {
+		if err := upgrade.ValidateVersionChange(oldSCP.Status.Version, oldSCP.Spec.Version, scp.Spec.Version); err != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("version"), err.Error()))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(scpv1alpha2.GroupVersion.WithKind("StewardControlPlane").GroupKind(), scp.Name, allErrs)
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/cluster-api/util/topology"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+//+kubebuilder:webhook:path=/mutate-controlplane-cluster-x-k8s-io-v1alpha2-stewardcontrolplanetemplate,mutating=true,failurePolicy=fail,matchPolicy=Equivalent,sideEffects=None,groups=controlplane.cluster.x-k8s.io,resources=stewardcontrolplanetemplates,verbs=create;update,versions=v1alpha2,name=default.stewardcontrolplanetemplate.controlplane.cluster.x-k8s.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-controlplane-cluster-x-k8s-io-v1alpha2-stewardcontrolplanetemplate,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,sideEffects=None,groups=controlplane.cluster.x-k8s.io,resources=stewardcontrolplanetemplates,verbs=create;update,versions=v1alpha2,name=validation.stewardcontrolplanetemplate.controlplane.cluster.x-k8s.io,admissionReviewVersions=v1
+
+// StewardControlPlaneTemplate implements the defaulting and validating webhooks for the StewardControlPlaneTemplate resource:
+// the templated fields share defaults and rules of the StewardControlPlane, and the spec is immutable as required by ClusterClass.
+type StewardControlPlaneTemplate struct{}
+
+var (
+	_ webhook.CustomDefaulter = &StewardControlPlaneTemplate{}
+	_ webhook.CustomValidator = &StewardControlPlaneTemplate{}
+)
+
+func (w *StewardControlPlaneTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	//nolint:wrapcheck
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&scpv1alpha2.StewardControlPlaneTemplate{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+func (w *StewardControlPlaneTemplate) Default(_ context.Context, obj runtime.Object) error {
+	tpl, ok := obj.(*scpv1alpha2.StewardControlPlaneTemplate)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlaneTemplate but got a %T", obj))
+	}
+
+	defaultStewardControlPlaneFields(&tpl.Spec.Template.Spec)
+
+	return nil
+}
+
+func (w *StewardControlPlaneTemplate) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tpl, ok := obj.(*scpv1alpha2.StewardControlPlaneTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlaneTemplate but got a %T", obj))
+	}
+
+	return nil, w.validate(tpl, nil, false)
+}
+
+func (w *StewardControlPlaneTemplate) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldTpl, ok := oldObj.(*scpv1alpha2.StewardControlPlaneTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlaneTemplate but got a %T", oldObj))
+	}
+
+	tpl, ok := newObj.(*scpv1alpha2.StewardControlPlaneTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a StewardControlPlaneTemplate but got a %T", newObj))
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an admission.Request inside context: %v", err))
+	}
+	// The topology controller performs dry-run requests to compute the changes of the templates,
+	// immutability must not be enforced for them.
+	return nil, w.validate(tpl, oldTpl, topology.ShouldSkipImmutabilityChecks(req, tpl))
+}
+
+func (w *StewardControlPlaneTemplate) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *StewardControlPlaneTemplate) validate(tpl, oldTpl *scpv1alpha2.StewardControlPlaneTemplate, skipImmutability bool) error {
+	templatePath := field.NewPath("spec", "template")
+
+	allErrs := tpl.Spec.Template.ObjectMeta.Validate(templatePath.Child("metadata"))
+	allErrs = append(allErrs, validateStewardControlPlaneFields(tpl.Spec.Template.Spec, templatePath.Child("spec"))...)
+
+	if oldTpl != nil && !skipImmutability {
+		// Templates created before the defaulting webhook was available could miss some values:
+		// applying the same defaults to avoid rejecting updates not changing the spec at all.
+		oldSpec := oldTpl.Spec.DeepCopy()
+		defaultStewardControlPlaneFields(&oldSpec.Template.Spec)
+
+		if !equality.Semantic.DeepEqual(oldSpec, &tpl.Spec) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "StewardControlPlaneTemplate spec is immutable, create a new resource instead"))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(scpv1alpha2.GroupVersion.WithKind("StewardControlPlaneTemplate").GroupKind(), tpl.Name, allErrs)
+}
@@ -0,0 +1,236 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+	"github.com/butlerdotdev/cluster-api-control-plane-provider-steward/pkg/externalclusterreference"
+)
+
+// validateVersion ensures the Kubernetes version is a valid semantic version,
+// tolerating the "v" prefix as the controller does when translating it to the TenantControlPlane.
+func validateVersion(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "the Kubernetes version is required")}
+	}
+
+	if _, err := version.ParseSemantic(value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be a valid semantic version, such as v1.30.2: "+err.Error())}
+	}
+
+	return nil
+}
+
+func validateStewardControlPlaneSpec(spec scpv1alpha2.StewardControlPlaneSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := validateVersion(spec.Version, fldPath.Child("version"))
+
+	return append(allErrs, validateStewardControlPlaneFields(spec.StewardControlPlaneFields, fldPath)...)
+}
+
+// ratchetErrors drops the errors already reported for the previous object, such as when the field has not been changed.
+func ratchetErrors(allErrs, oldErrs field.ErrorList) field.ErrorList {
+	existing := make(map[string]struct{}, len(oldErrs))
+	for _, err := range oldErrs {
+		existing[err.Error()] = struct{}{}
+	}
+
+	var errs field.ErrorList
+
+	for _, err := range allErrs {
+		if _, ok := existing[err.Error()]; !ok {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// validateStewardControlPlaneFields validates the fields shared between StewardControlPlane and StewardControlPlaneTemplate.
+func validateStewardControlPlaneFields(fields scpv1alpha2.StewardControlPlaneFields, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateNetworkComponent(fields.Network, fldPath.Child("network"))...)
+
+	if fields.Remediation != nil {
+		allErrs = append(allErrs, validateRemediation(*fields.Remediation, fldPath.Child("remediation"))...)
+	}
+
+	if fields.UserKubeconfig != nil {
+		allErrs = append(allErrs, validateUserKubeconfig(*fields.UserKubeconfig, fldPath.Child("userKubeconfig"))...)
+	}
+
+	// The provided Certificate Authority is not replicated, the <cluster>-ca Secret must contain its private key to be seeded into Steward.
+	if ca := fields.CertificateAuthority; ca.Source == scpv1alpha2.ClusterCertificateAuthoritySource && ca.Replication == scpv1alpha2.CertificateOnlyCertificateAuthorityReplication {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateAuthority", "replication"), ca.Replication, "not supported with the Cluster source, which requires the private key"))
+	}
+
+	if fields.KubeconfigServer != nil && fields.KubeconfigServer.URL != "" {
+		allErrs = append(allErrs, validateKubeconfigServerURL(fields.KubeconfigServer.URL, fldPath.Child("kubeconfigServer", "url"))...)
+	}
+
+	if ref := fields.Deployment.ExternalClusterReference; ref != nil && ref.Naming != nil && ref.Naming.Strategy == scpv1alpha2.TemplateRemoteNamingStrategy {
+		if err := externalclusterreference.ValidateNamingTemplate(ref.Naming.Template); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deployment", "externalClusterReference", "naming", "template"), ref.Naming.Template, err.Error()))
+		}
+	}
+
+	if coreDNS := fields.Addons.CoreDNS; coreDNS != nil {
+		dnsPath := fldPath.Child("addons", "coreDNS", "dnsServiceIPs")
+
+		for i, ip := range coreDNS.DNSServiceIPs {
+			allErrs = append(allErrs, validation.IsValidIP(dnsPath.Index(i), ip)...)
+		}
+		// The CoreDNS addon Service IPs take precedence over the network ones:
+		// rejecting different values since one of the two would be silently ignored.
+		if len(coreDNS.DNSServiceIPs) > 0 && len(fields.Network.DNSServiceIPs) > 0 && !slices.Equal(coreDNS.DNSServiceIPs, fields.Network.DNSServiceIPs) {
+			allErrs = append(allErrs, field.Invalid(dnsPath, coreDNS.DNSServiceIPs, "conflicts with spec.network.dnsServiceIPs, only one of them must be set"))
+		}
+	}
+
+	return allErrs
+}
+
+//nolint:cyclop
+func validateNetworkComponent(network scpv1alpha2.NetworkComponent, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, san := range network.CertSANs {
+		// nil error means the entry is in the form of <HOST>:<PORT> which is not accepted by Steward,
+		// see github.com/butlerdotdev/steward/issues/679
+		if _, _, err := net.SplitHostPort(san); err == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("certSANs").Index(i), san, "a certificate SAN must be made of host only with no port"))
+		}
+	}
+
+	if network.Ingress != nil {
+		allErrs = append(allErrs, validateHostname(network.Ingress.Hostname, fldPath.Child("ingress", "hostname"))...)
+	}
+
+	if network.Gateway != nil {
+		allErrs = append(allErrs, validateHostname(network.Gateway.Hostname, fldPath.Child("gateway", "hostname"))...)
+
+		if network.Ingress != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("gateway"), "using both ingress and gateway is not supported"))
+		}
+	}
+
+	if lb := network.LoadBalancerConfig; lb != nil {
+		lbPath := fldPath.Child("loadBalancerConfig")
+
+		for i, cidr := range lb.LoadBalancerSourceRanges {
+			allErrs = append(allErrs, validation.IsValidCIDR(lbPath.Child("loadBalancerSourceRanges").Index(i), cidr)...)
+		}
+
+		if network.ServiceType != stewardv1alpha1.ServiceTypeLoadBalancer {
+			if len(lb.LoadBalancerSourceRanges) > 0 {
+				allErrs = append(allErrs, field.Forbidden(lbPath.Child("loadBalancerSourceRanges"), "supported only with LoadBalancer service type"))
+			}
+
+			if lb.LoadBalancerClass != nil {
+				allErrs = append(allErrs, field.Forbidden(lbPath.Child("loadBalancerClass"), "supported only with LoadBalancer service type"))
+			}
+		}
+	}
+
+	if network.ServiceAddress != "" {
+		allErrs = append(allErrs, validation.IsValidIP(fldPath.Child("serviceAddress"), network.ServiceAddress)...)
+	}
+
+	for i, ip := range network.DNSServiceIPs {
+		allErrs = append(allErrs, validation.IsValidIP(fldPath.Child("dnsServiceIPs").Index(i), ip)...)
+	}
+
+	return allErrs
+}
+
+func validateRemediation(remediation scpv1alpha2.RemediationSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if remediation.Timeout != nil && remediation.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), remediation.Timeout.Duration.String(), "must be a positive duration"))
+	}
+
+	if remediation.RetryPeriod != nil && remediation.RetryPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retryPeriod"), remediation.RetryPeriod.Duration.String(), "must be a positive duration"))
+	}
+
+	if remediation.MaxRetries != nil && *remediation.MaxRetries < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRetries"), *remediation.MaxRetries, "must be greater than or equal to 1"))
+	}
+
+	return allErrs
+}
+
+// validateUserKubeconfig prevents the user kubeconfig from being granted the cluster-admin permissions.
+func validateUserKubeconfig(userKubeconfig scpv1alpha2.UserKubeconfigSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, group := range userKubeconfig.Groups {
+		if group == user.SystemPrivilegedGroup {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("groups").Index(i), "the "+user.SystemPrivilegedGroup+" group is reserved to the admin kubeconfig"))
+		}
+	}
+
+	if userKubeconfig.CertificateValidity != nil && userKubeconfig.CertificateValidity.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateValidity"), userKubeconfig.CertificateValidity.Duration.String(), "must be a positive duration"))
+	}
+
+	return allErrs
+}
+
+// validateKubeconfigServerURL ensures the kubeconfig server is an absolute HTTPS URL, as expected by the clients.
+func validateKubeconfigServerURL(value string, fldPath *field.Path) field.ErrorList {
+	u, err := url.Parse(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be a valid URL: "+err.Error())}
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be in the form of https://<HOST>[:<PORT>]")}
+	}
+
+	return nil
+}
+
+// validateHostname checks the Ingress or Gateway hostname, which is used as Control Plane endpoint
+// and must be in the form of <FQDN> or <FQDN>:<PORT>.
+func validateHostname(hostname string, fldPath *field.Path) field.ErrorList {
+	host := hostname
+
+	if strings.Contains(hostname, ":") {
+		h, strPort, err := net.SplitHostPort(hostname)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, hostname, "must be in the form of <FQDN> or <FQDN>:<PORT>")}
+		}
+
+		port, err := strconv.Atoi(strPort)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, hostname, "port must be numeric")}
+		}
+
+		if msgs := validation.IsValidPortNum(port); len(msgs) > 0 {
+			return field.ErrorList{field.Invalid(fldPath, hostname, strings.Join(msgs, ", "))}
+		}
+
+		host = h
+	}
+
+	if msgs := validation.IsDNS1123Subdomain(host); len(msgs) > 0 {
+		return field.ErrorList{field.Invalid(fldPath, hostname, strings.Join(msgs, ", "))}
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 Butler Labs
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	stewardv1alpha1 "github.com/butlerdotdev/steward/api/v1alpha1"
+	. "github.com/onsi/gomega" //nolint:revive
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	scpv1alpha2 "github.com/butlerdotdev/cluster-api-control-plane-provider-steward/api/v1alpha2"
+)
+
+func TestValidateVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		valid   bool
+	}{
+		{name: "with prefix", version: "v1.30.2", valid: true},
+		{name: "without prefix", version: "1.30.2", valid: true},
+		{name: "pre-release", version: "v1.31.0-rc.1", valid: true},
+		{name: "empty", version: ""},
+		{name: "missing patch", version: "v1.30"},
+		{name: "not a version", version: "latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := validateVersion(tt.version, field.NewPath("spec", "version"))
+			g.Expect(errs.ToAggregate() == nil).To(Equal(tt.valid), "%v", errs)
+		})
+	}
+}
+
+func TestValidateNetworkComponent(t *testing.T) {
+	tests := []struct {
+		name    string
+		network scpv1alpha2.NetworkComponent
+		fields  []string
+	}{
+		{name: "empty"},
+		{
+			name:    "certificate SAN with port",
+			network: scpv1alpha2.NetworkComponent{CertSANs: []string{"api.example.com", "api.example.com:6443"}},
+			fields:  []string{"spec.network.certSANs[1]"},
+		},
+		{
+			name:    "ingress hostname with port",
+			network: scpv1alpha2.NetworkComponent{Ingress: &scpv1alpha2.IngressComponent{Hostname: "api.example.com:443"}},
+		},
+		{
+			name:    "ingress hostname with invalid port",
+			network: scpv1alpha2.NetworkComponent{Ingress: &scpv1alpha2.IngressComponent{Hostname: "api.example.com:http"}},
+			fields:  []string{"spec.network.ingress.hostname"},
+		},
+		{
+			name:    "gateway hostname not a subdomain",
+			network: scpv1alpha2.NetworkComponent{Gateway: &scpv1alpha2.GatewayComponent{Hostname: "API_example"}},
+			fields:  []string{"spec.network.gateway.hostname"},
+		},
+		{
+			name: "both ingress and gateway",
+			network: scpv1alpha2.NetworkComponent{
+				Ingress: &scpv1alpha2.IngressComponent{Hostname: "api.example.com"},
+				Gateway: &scpv1alpha2.GatewayComponent{Hostname: "api.example.com"},
+			},
+			fields: []string{"spec.network.gateway"},
+		},
+		{
+			name: "load balancer settings with another service type",
+			network: scpv1alpha2.NetworkComponent{
+				ServiceType: stewardv1alpha1.ServiceTypeClusterIP,
+				LoadBalancerConfig: &scpv1alpha2.LoadBalancerConfig{
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+					LoadBalancerClass:        ptr.To("internal"),
+				},
+			},
+			fields: []string{"spec.network.loadBalancerConfig.loadBalancerSourceRanges", "spec.network.loadBalancerConfig.loadBalancerClass"},
+		},
+		{
+			name: "invalid load balancer source range",
+			network: scpv1alpha2.NetworkComponent{
+				ServiceType:        stewardv1alpha1.ServiceTypeLoadBalancer,
+				LoadBalancerConfig: &scpv1alpha2.LoadBalancerConfig{LoadBalancerSourceRanges: []string{"10.0.0.0"}},
+			},
+			fields: []string{"spec.network.loadBalancerConfig.loadBalancerSourceRanges[0]"},
+		},
+		{
+			name:    "invalid addresses",
+			network: scpv1alpha2.NetworkComponent{ServiceAddress: "10.0.0", DNSServiceIPs: []string{"10.96.0.10", "dns"}},
+			fields:  []string{"spec.network.serviceAddress", "spec.network.dnsServiceIPs[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(errorFields(validateNetworkComponent(tt.network, field.NewPath("spec", "network")))).To(Equal(tt.fields))
+		})
+	}
+}
+
+func TestValidateStewardControlPlaneFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields scpv1alpha2.StewardControlPlaneFields
+		errors []string
+	}{
+		{name: "empty"},
+		{
+			name: "invalid remediation",
+			fields: scpv1alpha2.StewardControlPlaneFields{Remediation: &scpv1alpha2.RemediationSpec{
+				Timeout:     &metav1.Duration{Duration: -time.Minute},
+				RetryPeriod: &metav1.Duration{},
+				MaxRetries:  ptr.To[int32](0),
+			}},
+			errors: []string{"spec.remediation.timeout", "spec.remediation.retryPeriod", "spec.remediation.maxRetries"},
+		},
+		{
+			name: "privileged user kubeconfig",
+			fields: scpv1alpha2.StewardControlPlaneFields{UserKubeconfig: &scpv1alpha2.UserKubeconfigSpec{
+				Groups:              []string{"developers", "system:masters"},
+				CertificateValidity: &metav1.Duration{},
+			}},
+			errors: []string{"spec.userKubeconfig.groups[1]", "spec.userKubeconfig.certificateValidity"},
+		},
+		{
+			name: "provided Certificate Authority without private key",
+			fields: scpv1alpha2.StewardControlPlaneFields{CertificateAuthority: scpv1alpha2.CertificateAuthoritySpec{
+				Source:      scpv1alpha2.ClusterCertificateAuthoritySource,
+				Replication: scpv1alpha2.CertificateOnlyCertificateAuthorityReplication,
+			}},
+			errors: []string{"spec.certificateAuthority.replication"},
+		},
+		{
+			name:   "kubeconfig server without HTTPS",
+			fields: scpv1alpha2.StewardControlPlaneFields{KubeconfigServer: &scpv1alpha2.KubeconfigServerSpec{URL: "http://api.example.com"}},
+			errors: []string{"spec.kubeconfigServer.url"},
+		},
+		{
+			name:   "kubeconfig server with HTTPS",
+			fields: scpv1alpha2.StewardControlPlaneFields{KubeconfigServer: &scpv1alpha2.KubeconfigServerSpec{URL: "https://api.example.com:6443"}},
+		},
+		{
+			name: "invalid naming template",
+			fields: scpv1alpha2.StewardControlPlaneFields{Deployment: scpv1alpha2.DeploymentComponent{
+				ExternalClusterReference: &scpv1alpha2.ExternalClusterReference{Naming: &scpv1alpha2.RemoteNamingSpec{
+					Strategy: scpv1alpha2.TemplateRemoteNamingStrategy,
+					Template: "{{ .Unknown }",
+				}},
+			}},
+			errors: []string{"spec.deployment.externalClusterReference.naming.template"},
+		},
+		{
+			name: "conflicting DNS service IPs",
+			fields: scpv1alpha2.StewardControlPlaneFields{
+				Network: scpv1alpha2.NetworkComponent{DNSServiceIPs: []string{"10.96.0.10"}},
+				Addons:  scpv1alpha2.AddonsSpec{CoreDNS: &scpv1alpha2.CoreDNSAddonSpec{DNSServiceIPs: []string{"10.96.0.20"}}},
+			},
+			errors: []string{"spec.addons.coreDNS.dnsServiceIPs"},
+		},
+		{
+			name: "matching DNS service IPs",
+			fields: scpv1alpha2.StewardControlPlaneFields{
+				Network: scpv1alpha2.NetworkComponent{DNSServiceIPs: []string{"10.96.0.10"}},
+				Addons:  scpv1alpha2.AddonsSpec{CoreDNS: &scpv1alpha2.CoreDNSAddonSpec{DNSServiceIPs: []string{"10.96.0.10"}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(errorFields(validateStewardControlPlaneFields(tt.fields, field.NewPath("spec")))).To(Equal(tt.errors))
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	// The CoreDNS addon Service IPs conflicting with the network ones have been rejected only later.
+	invalid := func() *scpv1alpha2.StewardControlPlane {
+		scp := &scpv1alpha2.StewardControlPlane{}
+		scp.Spec.Version = "v1.30.2"
+		scp.Spec.Network.DNSServiceIPs = []string{"10.96.0.10"}
+		scp.Spec.Addons.CoreDNS = &scpv1alpha2.CoreDNSAddonSpec{DNSServiceIPs: []string{"10.96.0.20"}}
+
+		return scp
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(scp *scpv1alpha2.StewardControlPlane)
+		valid  bool
+	}{
+		{
+			name:   "unchanged invalid field",
+			mutate: func(scp *scpv1alpha2.StewardControlPlane) { scp.Spec.Replicas = ptr.To[int32](3) },
+			valid:  true,
+		},
+		{
+			name: "changed invalid field",
+			mutate: func(scp *scpv1alpha2.StewardControlPlane) {
+				scp.Spec.Addons.CoreDNS.DNSServiceIPs = []string{"10.96.0.30"}
+			},
+		},
+		{
+			name: "newly invalid field",
+			mutate: func(scp *scpv1alpha2.StewardControlPlane) {
+				scp.Spec.Network.CertSANs = []string{"api.example.com:6443"}
+			},
+		},
+		{
+			name: "finalizer removal of a deleted object",
+			mutate: func(scp *scpv1alpha2.StewardControlPlane) {
+				scp.DeletionTimestamp = ptr.To(metav1.Now())
+				scp.Spec.Network.CertSANs = []string{"api.example.com:6443"}
+			},
+			valid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scp := invalid()
+			tt.mutate(scp)
+
+			_, err := (&StewardControlPlane{}).ValidateUpdate(context.Background(), invalid(), scp)
+			g.Expect(err == nil).To(Equal(tt.valid), "%v", err)
+		})
+	}
+}
+
+func errorFields(errs field.ErrorList) []string {
+	var fields []string
+
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+
+	return fields
+}